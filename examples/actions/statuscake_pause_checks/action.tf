@@ -0,0 +1,7 @@
+action "statuscake_pause_checks" "deploy" {
+  config {
+    tags = [
+      "production",
+    ]
+  }
+}
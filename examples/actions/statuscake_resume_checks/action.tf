@@ -0,0 +1,7 @@
+action "statuscake_resume_checks" "deploy" {
+  config {
+    tags = [
+      "production",
+    ]
+  }
+}
@@ -0,0 +1,8 @@
+data "statuscake_maintenance_windows" "active" {
+  state = "active"
+  tag   = "production"
+}
+
+output "production_maintenance_active" {
+  value = length(data.statuscake_maintenance_windows.active.maintenance_windows) > 0
+}
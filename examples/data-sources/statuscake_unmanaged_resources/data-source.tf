@@ -0,0 +1,22 @@
+data "statuscake_unmanaged_resources" "checks" {
+  managed {
+    type = "statuscake_ssl_check"
+    ids  = [for check in statuscake_ssl_check.all : check.id]
+  }
+
+  managed {
+    type = "statuscake_uptime_check"
+    ids  = [for check in statuscake_uptime_check.all : check.id]
+  }
+
+  types = [
+    "statuscake_ssl_check",
+    "statuscake_uptime_check",
+  ]
+}
+
+output "unmanaged_checks_by_tag" {
+  value = {
+    for group in data.statuscake_unmanaged_resources.checks.groups : "${group.type}/${group.tag}" => group.ids
+  }
+}
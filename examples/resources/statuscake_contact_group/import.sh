@@ -1 +1,4 @@
 terraform import statuscake_contact_group.operations_team 1234
+
+# The only object with the given name may also be imported.
+terraform import statuscake_contact_group.operations_team "name=Operations Team"
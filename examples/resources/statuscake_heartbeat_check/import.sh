@@ -1 +1,5 @@
 terraform import statuscake_heartbeat_check.example_com 1234
+
+# The only object with the given name or tag may also be imported.
+terraform import statuscake_heartbeat_check.example_com name=Example
+terraform import statuscake_heartbeat_check.example_com tag=production
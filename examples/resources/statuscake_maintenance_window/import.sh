@@ -1 +1,5 @@
 terraform import statuscake_maintenance_window.weekends 1234
+
+# The only object with the given name or tag may also be imported.
+terraform import statuscake_maintenance_window.weekends name=Weekends
+terraform import statuscake_maintenance_window.weekends tag=production
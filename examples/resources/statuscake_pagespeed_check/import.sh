@@ -1 +1,4 @@
 terraform import statuscake_pagespeed_check.example_com 1234
+
+# The only object with the given name may also be imported.
+terraform import statuscake_pagespeed_check.example_com name=Example
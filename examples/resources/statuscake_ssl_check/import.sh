@@ -1 +1,4 @@
 terraform import statuscake_ssl_check.example_com 1234
+
+# The only object with the given address may also be imported.
+terraform import statuscake_ssl_check.example_com address=https://www.example.com
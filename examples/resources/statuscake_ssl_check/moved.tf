@@ -0,0 +1,4 @@
+moved {
+  from = statuscake_ssl.example_com
+  to   = statuscake_ssl_check.example_com
+}
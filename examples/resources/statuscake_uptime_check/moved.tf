@@ -0,0 +1,4 @@
+moved {
+  from = statuscake_test.example_com
+  to   = statuscake_uptime_check.example_com
+}
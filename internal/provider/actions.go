@@ -0,0 +1,265 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+
+	"github.com/StatusCakeDev/statuscake-go"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// The plugin SDK does not support Terraform actions. Actions are therefore
+// served by providerServer, which handles the action RPCs directly. Each action
+// operates on uptime checks identified by ID or by tag.
+
+// pauseChecksAction pauses uptime checks, typically during a deployment.
+const pauseChecksAction = "statuscake_pause_checks"
+
+// resumeChecksAction resumes uptime checks paused by pauseChecksAction.
+const resumeChecksAction = "statuscake_resume_checks"
+
+// checksActionType is the type of the configuration of each action.
+var checksActionType = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		"ids":  tftypes.Set{ElementType: tftypes.String},
+		"tags": tftypes.Set{ElementType: tftypes.String},
+	},
+}
+
+// actions returns whether each action pauses the checks it operates on,
+// keyed by the action type.
+func actions() map[string]bool {
+	return map[string]bool{
+		pauseChecksAction:  true,
+		resumeChecksAction: false,
+	}
+}
+
+// checksActionSchema returns the schema of an action that pauses or resumes
+// uptime checks.
+func checksActionSchema(paused bool) *tfprotov5.ActionSchema {
+	verb := "resume"
+	if paused {
+		verb = "pause"
+	}
+
+	return &tfprotov5.ActionSchema{
+		Schema: &tfprotov5.Schema{
+			Block: &tfprotov5.SchemaBlock{
+				Description: fmt.Sprintf("Action to %s uptime checks without changing the `paused` attribute of managed checks", verb),
+				Attributes: []*tfprotov5.SchemaAttribute{
+					{
+						Name:        "ids",
+						Type:        tftypes.Set{ElementType: tftypes.String},
+						Optional:    true,
+						Description: fmt.Sprintf("List of uptime check IDs to %s", verb),
+					},
+					{
+						Name:        "tags",
+						Type:        tftypes.Set{ElementType: tftypes.String},
+						Optional:    true,
+						Description: fmt.Sprintf("List of tags. Uptime checks with any of the tags are %sd", verb),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (s *providerServer) ValidateActionConfig(ctx context.Context, req *tfprotov5.ValidateActionConfigRequest) (*tfprotov5.ValidateActionConfigResponse, error) {
+	if _, ok := actions()[req.ActionType]; !ok {
+		return s.GRPCProviderServer.ValidateActionConfig(ctx, req)
+	}
+
+	ids, tags, known, err := decodeChecksActionConfig(req.Config)
+	if err != nil {
+		return &tfprotov5.ValidateActionConfigResponse{
+			Diagnostics: errorDiagnostics("Invalid action configuration", err),
+		}, nil
+	}
+
+	if known && len(ids) == 0 && len(tags) == 0 {
+		return &tfprotov5.ValidateActionConfigResponse{
+			Diagnostics: errorDiagnostics("Invalid action configuration", errors.New("at least one of ids or tags must be specified")),
+		}, nil
+	}
+
+	return &tfprotov5.ValidateActionConfigResponse{}, nil
+}
+
+func (s *providerServer) PlanAction(ctx context.Context, req *tfprotov5.PlanActionRequest) (*tfprotov5.PlanActionResponse, error) {
+	if _, ok := actions()[req.ActionType]; !ok {
+		return s.GRPCProviderServer.PlanAction(ctx, req)
+	}
+
+	if _, _, _, err := decodeChecksActionConfig(req.Config); err != nil {
+		return &tfprotov5.PlanActionResponse{
+			Diagnostics: errorDiagnostics("Invalid action configuration", err),
+		}, nil
+	}
+
+	return &tfprotov5.PlanActionResponse{}, nil
+}
+
+func (s *providerServer) InvokeAction(ctx context.Context, req *tfprotov5.InvokeActionRequest) (*tfprotov5.InvokeActionServerStream, error) {
+	paused, ok := actions()[req.ActionType]
+	if !ok {
+		return s.GRPCProviderServer.InvokeAction(ctx, req)
+	}
+
+	return &tfprotov5.InvokeActionServerStream{
+		Events: func(yield func(tfprotov5.InvokeActionEvent) bool) {
+			stopped := false
+			err := s.invokeChecksAction(ctx, req.Config, paused, func(msg string) bool {
+				stopped = !yield(tfprotov5.InvokeActionEvent{
+					Type: tfprotov5.ProgressInvokeActionEventType{Message: msg},
+				})
+				return !stopped
+			})
+
+			// No further events may be sent once the consumer has stopped.
+			if stopped {
+				return
+			}
+
+			var diags []*tfprotov5.Diagnostic
+			if err != nil {
+				diags = errorDiagnostics(fmt.Sprintf("Failed to invoke %s", req.ActionType), err)
+			}
+
+			yield(tfprotov5.InvokeActionEvent{
+				Type: tfprotov5.CompletedInvokeActionEventType{Diagnostics: diags},
+			})
+		},
+	}, nil
+}
+
+// invokeChecksAction pauses or resumes every uptime check matching the action
+// configuration. Progress is reported for each check. Failing to update one
+// check does not prevent the remaining checks from being updated.
+func (s *providerServer) invokeChecksAction(ctx context.Context, config *tfprotov5.DynamicValue, paused bool, progress func(string) bool) error {
+	meta, ok := s.provider.Meta().(*providerConfig)
+	if !ok {
+		return errors.New("the provider has not been configured")
+	}
+
+	ids, tags, _, err := decodeChecksActionConfig(config)
+	if err != nil {
+		return err
+	}
+
+	if len(tags) != 0 {
+		checks, err := listUptimeChecks(ctx, meta.client)
+		if err != nil {
+			return err
+		}
+
+		ids = append(ids, matchUptimeChecksByTag(checks, tags)...)
+	}
+
+	sort.Strings(ids)
+	ids = slices.Compact(ids)
+
+	var errs []error
+	for _, id := range ids {
+		log.Printf("[DEBUG] Updating StatusCake uptime check with ID: %s, paused: %t", id, paused)
+
+		if err := meta.client.UpdateUptimeTest(ctx, id).Paused(paused).Execute(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to update uptime check with ID: %s, error: %w", id, err))
+			continue
+		}
+
+		msg := fmt.Sprintf("Resumed uptime check %s", id)
+		if paused {
+			msg = fmt.Sprintf("Paused uptime check %s", id)
+		}
+
+		if !progress(msg) {
+			return nil
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// matchUptimeChecksByTag returns the IDs of the uptime checks with any of the
+// given tags.
+func matchUptimeChecksByTag(checks []statuscake.UptimeTestOverview, tags []string) []string {
+	var ids []string
+	for _, check := range checks {
+		for _, tag := range check.Tags {
+			if slices.Contains(tags, tag) {
+				ids = append(ids, check.ID)
+				break
+			}
+		}
+	}
+	return ids
+}
+
+// decodeChecksActionConfig returns the uptime check IDs and tags within the
+// action configuration. It also reports whether every value is known.
+func decodeChecksActionConfig(config *tfprotov5.DynamicValue) ([]string, []string, bool, error) {
+	if config == nil {
+		return nil, nil, true, nil
+	}
+
+	v, err := config.Unmarshal(checksActionType)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	if !v.IsFullyKnown() {
+		return nil, nil, false, nil
+	}
+
+	if v.IsNull() {
+		return nil, nil, true, nil
+	}
+
+	var attrs map[string]tftypes.Value
+	if err := v.As(&attrs); err != nil {
+		return nil, nil, false, err
+	}
+
+	ids, err := decodeStringSet(attrs["ids"])
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("ids: %w", err)
+	}
+
+	tags, err := decodeStringSet(attrs["tags"])
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("tags: %w", err)
+	}
+
+	return ids, tags, true, nil
+}
+
+// decodeStringSet returns the elements of a known set of strings. A null set
+// has no elements.
+func decodeStringSet(v tftypes.Value) ([]string, error) {
+	if v.IsNull() {
+		return nil, nil
+	}
+
+	var elems []tftypes.Value
+	if err := v.As(&elems); err != nil {
+		return nil, err
+	}
+
+	s := make([]string, 0, len(elems))
+	for _, elem := range elems {
+		var str string
+		if err := elem.As(&str); err != nil {
+			return nil, err
+		}
+		s = append(s, str)
+	}
+
+	return s, nil
+}
@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/StatusCakeDev/statuscake-go"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testChecksActionConfig returns the configuration of an action with the given
+// ids and tags attribute values.
+func testChecksActionConfig(t *testing.T, ids, tags tftypes.Value) *tfprotov5.DynamicValue {
+	t.Helper()
+
+	config, err := tfprotov5.NewDynamicValue(checksActionType, tftypes.NewValue(checksActionType, map[string]tftypes.Value{
+		"ids":  ids,
+		"tags": tags,
+	}))
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+	return &config
+}
+
+// testStringSet returns a known set of strings.
+func testStringSet(elems ...string) tftypes.Value {
+	values := make([]tftypes.Value, 0, len(elems))
+	for _, elem := range elems {
+		values = append(values, tftypes.NewValue(tftypes.String, elem))
+	}
+	return tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, values)
+}
+
+func TestDecodeChecksActionConfig(t *testing.T) {
+	stringSet := tftypes.Set{ElementType: tftypes.String}
+	null := tftypes.NewValue(stringSet, nil)
+
+	tests := []struct {
+		name         string
+		ids          tftypes.Value
+		tags         tftypes.Value
+		expectedIDs  []string
+		expectedTags []string
+		known        bool
+	}{
+		{
+			name:         "decodes ids and tags",
+			ids:          testStringSet("1", "2"),
+			tags:         testStringSet("production"),
+			expectedIDs:  []string{"1", "2"},
+			expectedTags: []string{"production"},
+			known:        true,
+		},
+		{
+			name:  "decodes null sets as empty",
+			ids:   null,
+			tags:  null,
+			known: true,
+		},
+		{
+			name:         "decodes empty sets as empty",
+			ids:          testStringSet(),
+			tags:         testStringSet(),
+			expectedIDs:  []string{},
+			expectedTags: []string{},
+			known:        true,
+		},
+		{
+			name:  "reports an unknown set",
+			ids:   tftypes.NewValue(stringSet, tftypes.UnknownValue),
+			tags:  testStringSet("production"),
+			known: false,
+		},
+		{
+			name: "reports an unknown element",
+			ids: tftypes.NewValue(stringSet, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "1"),
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			tags:  null,
+			known: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids, tags, known, err := decodeChecksActionConfig(testChecksActionConfig(t, tt.ids, tt.tags))
+			if err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+
+			// Sets are unordered.
+			sort.Strings(ids)
+			sort.Strings(tags)
+
+			if !reflect.DeepEqual(ids, tt.expectedIDs) {
+				t.Errorf("expected ids %+v but got %+v", tt.expectedIDs, ids)
+			}
+			if !reflect.DeepEqual(tags, tt.expectedTags) {
+				t.Errorf("expected tags %+v but got %+v", tt.expectedTags, tags)
+			}
+			if known != tt.known {
+				t.Errorf("expected known %t but got %t", tt.known, known)
+			}
+		})
+	}
+}
+
+func TestDecodeChecksActionConfigNull(t *testing.T) {
+	config, err := tfprotov5.NewDynamicValue(checksActionType, tftypes.NewValue(checksActionType, nil))
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+
+	for name, config := range map[string]*tfprotov5.DynamicValue{"nil": nil, "null": &config} {
+		t.Run(name, func(t *testing.T) {
+			ids, tags, known, err := decodeChecksActionConfig(config)
+			if err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+
+			if ids != nil || tags != nil || !known {
+				t.Errorf("expected no ids or tags but got %+v, %+v (known: %t)", ids, tags, known)
+			}
+		})
+	}
+}
+
+func TestDecodeChecksActionConfigInvalid(t *testing.T) {
+	configType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"ids": tftypes.Number,
+		},
+	}
+
+	config, err := tfprotov5.NewDynamicValue(configType, tftypes.NewValue(configType, map[string]tftypes.Value{
+		"ids": tftypes.NewValue(tftypes.Number, 1),
+	}))
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+
+	if _, _, _, err := decodeChecksActionConfig(&config); err == nil {
+		t.Error("expected an error but got none")
+	}
+}
+
+func TestValidateActionConfig(t *testing.T) {
+	stringSet := tftypes.Set{ElementType: tftypes.String}
+	null := tftypes.NewValue(stringSet, nil)
+
+	tests := []struct {
+		name  string
+		ids   tftypes.Value
+		tags  tftypes.Value
+		valid bool
+	}{
+		{
+			name:  "accepts ids",
+			ids:   testStringSet("1"),
+			tags:  null,
+			valid: true,
+		},
+		{
+			name:  "accepts tags",
+			ids:   null,
+			tags:  testStringSet("production"),
+			valid: true,
+		},
+		{
+			name:  "accepts unknown values",
+			ids:   tftypes.NewValue(stringSet, tftypes.UnknownValue),
+			tags:  null,
+			valid: true,
+		},
+		{
+			name:  "rejects neither ids nor tags",
+			ids:   null,
+			tags:  testStringSet(),
+			valid: false,
+		},
+	}
+
+	s := &providerServer{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := s.ValidateActionConfig(context.Background(), &tfprotov5.ValidateActionConfigRequest{
+				ActionType: pauseChecksAction,
+				Config:     testChecksActionConfig(t, tt.ids, tt.tags),
+			})
+			if err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+
+			if valid := len(resp.Diagnostics) == 0; valid != tt.valid {
+				t.Errorf("expected valid %t but got diagnostics: %+v", tt.valid, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestMatchUptimeChecksByTag(t *testing.T) {
+	checks := []statuscake.UptimeTestOverview{
+		{ID: "1", Tags: []string{"production", "web"}},
+		{ID: "2", Tags: []string{"staging"}},
+		{ID: "3", Tags: []string{"web"}},
+		{ID: "4"},
+	}
+
+	tests := []struct {
+		name     string
+		tags     []string
+		expected []string
+	}{
+		{name: "matches a single tag", tags: []string{"web"}, expected: []string{"1", "3"}},
+		{name: "matches any of the tags", tags: []string{"production", "staging"}, expected: []string{"1", "2"}},
+		{name: "matches nothing", tags: []string{"missing"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := matchUptimeChecksByTag(checks, tt.tags); !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("expected %+v but got %+v", tt.expected, actual)
+			}
+		})
+	}
+}
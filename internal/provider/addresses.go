@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"net"
+	"net/netip"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// normalizeAddress returns the canonical form of a URL, FQDN, or IP address
+// such that addresses differing only cosmetically compare equal. Schemes and
+// hostnames are lowercased, default ports are removed, an empty path is
+// replaced with "/", and IP addresses are written in their shortest form.
+// Values that cannot be parsed are returned unchanged.
+func normalizeAddress(s string) string {
+	s = strings.TrimSpace(s)
+	if !strings.Contains(s, "://") {
+		// Only the host is normalized since the remainder of the address, such
+		// as its path, is case sensitive.
+		if i := strings.IndexAny(s, "/?#"); i >= 0 {
+			return normalizeHost(s[:i]) + s[i:]
+		}
+		return normalizeHost(s)
+	}
+
+	u, err := url.Parse(s)
+	if err != nil || u.Host == "" {
+		return s
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+
+	host, port := u.Hostname(), u.Port()
+	if (u.Scheme == "http" && port == "80") || (u.Scheme == "https" && port == "443") {
+		port = ""
+	}
+
+	host = normalizeHost(host)
+	if port != "" {
+		u.Host = net.JoinHostPort(host, port)
+	} else if strings.Contains(host, ":") {
+		u.Host = "[" + host + "]"
+	} else {
+		u.Host = host
+	}
+
+	if u.Path == "" && u.RawPath == "" {
+		u.Path = "/"
+	}
+
+	return u.String()
+}
+
+// normalizeHost returns the canonical form of a hostname or IP address.
+// Hostnames are lowercased and any trailing dot is removed.
+func normalizeHost(s string) string {
+	if ip, err := netip.ParseAddr(strings.Trim(s, "[]")); err == nil {
+		return ip.Unmap().String()
+	}
+	return strings.TrimSuffix(strings.ToLower(s), ".")
+}
+
+// suppressEquivalentAddress is a schema.SchemaDiffSuppressFunc that suppresses
+// differences between addresses with the same canonical form.
+func suppressEquivalentAddress(_, old, new string, _ *schema.ResourceData) bool {
+	return normalizeAddress(old) == normalizeAddress(new)
+}
+
+// flattenAddresses returns the configured addresses if they are equivalent to
+// those returned by the API. Otherwise the API values are returned.
+func flattenAddresses(configured *schema.Set, v []string) interface{} {
+	normalized := func(addresses []string) []string {
+		n := make([]string, len(addresses))
+		for i, address := range addresses {
+			n[i] = normalizeAddress(address)
+		}
+		return n
+	}
+
+	if equalStringSets(normalized(convertStringSet(configured)), normalized(v)) {
+		return configured.List()
+	}
+	return v
+}
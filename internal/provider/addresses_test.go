@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestNormalizeAddress(t *testing.T) {
+	tests := []struct {
+		name     string
+		address  string
+		expected string
+	}{
+		{name: "adds a trailing slash to an empty path", address: "https://www.example.com", expected: "https://www.example.com/"},
+		{name: "keeps a trailing slash on a path", address: "https://www.example.com/path/", expected: "https://www.example.com/path/"},
+		{name: "lowercases the scheme and hostname", address: "HTTPS://WWW.Example.COM/Path", expected: "https://www.example.com/Path"},
+		{name: "removes the default HTTP port", address: "http://www.example.com:80/", expected: "http://www.example.com/"},
+		{name: "removes the default HTTPS port", address: "https://www.example.com:443/", expected: "https://www.example.com/"},
+		{name: "keeps non-default ports", address: "https://www.example.com:8443/", expected: "https://www.example.com:8443/"},
+		{name: "keeps the HTTP port on HTTPS URLs", address: "https://www.example.com:80/", expected: "https://www.example.com:80/"},
+		{name: "removes a trailing dot from the hostname", address: "https://www.example.com./", expected: "https://www.example.com/"},
+		{name: "keeps the query and fragment", address: "https://www.example.com?q=1#top", expected: "https://www.example.com/?q=1#top"},
+		{name: "shortens IPv6 addresses in URLs", address: "http://[2001:DB8:0:0:0:0:0:1]/", expected: "http://[2001:db8::1]/"},
+		{name: "keeps ports on IPv6 addresses in URLs", address: "http://[2001:db8::1]:8080/", expected: "http://[2001:db8::1]:8080/"},
+		{name: "removes the default port from IPv6 addresses in URLs", address: "https://[2001:db8::1]:443", expected: "https://[2001:db8::1]/"},
+		{name: "lowercases hostnames", address: "WWW.Example.com", expected: "www.example.com"},
+		{name: "keeps the case of paths without a scheme", address: "WWW.Example.com/Foo", expected: "www.example.com/Foo"},
+		{name: "keeps the case of queries without a scheme", address: "Example.com?Q=1", expected: "example.com?Q=1"},
+		{name: "shortens IPv6 addresses", address: "2001:0db8:0000:0000:0000:0000:0000:0001", expected: "2001:db8::1"},
+		{name: "removes brackets from IPv6 addresses", address: "[2001:db8::1]", expected: "2001:db8::1"},
+		{name: "unmaps IPv4-mapped IPv6 addresses", address: "::ffff:203.0.113.1", expected: "203.0.113.1"},
+		{name: "trims surrounding whitespace", address: " 203.0.113.1 ", expected: "203.0.113.1"},
+		{name: "returns URLs without a host unchanged", address: "https:///path", expected: "https:///path"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := normalizeAddress(tt.address); actual != tt.expected {
+				t.Errorf("expected %q but got %q", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestNormalizeHost(t *testing.T) {
+	tests := []struct {
+		name     string
+		host     string
+		expected string
+	}{
+		{name: "lowercases hostnames", host: "WWW.Example.COM", expected: "www.example.com"},
+		{name: "removes a trailing dot", host: "www.example.com.", expected: "www.example.com"},
+		{name: "keeps IPv4 addresses", host: "203.0.113.1", expected: "203.0.113.1"},
+		{name: "shortens IPv6 addresses", host: "2001:DB8:0:0:0:0:0:1", expected: "2001:db8::1"},
+		{name: "removes brackets from IPv6 addresses", host: "[2001:db8::1]", expected: "2001:db8::1"},
+		{name: "unmaps IPv4-mapped IPv6 addresses", host: "::ffff:203.0.113.1", expected: "203.0.113.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := normalizeHost(tt.host); actual != tt.expected {
+				t.Errorf("expected %q but got %q", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSuppressEquivalentAddress(t *testing.T) {
+	tests := []struct {
+		name     string
+		old      string
+		new      string
+		expected bool
+	}{
+		{name: "identical addresses", old: "https://www.example.com/", new: "https://www.example.com/", expected: true},
+		{name: "missing trailing slash", old: "https://www.example.com/", new: "https://www.example.com", expected: true},
+		{name: "different case", old: "https://www.example.com/", new: "HTTPS://WWW.EXAMPLE.COM/", expected: true},
+		{name: "default port", old: "https://www.example.com/", new: "https://www.example.com:443/", expected: true},
+		{name: "expanded IPv6 address", old: "2001:db8::1", new: "2001:0db8:0:0:0:0:0:1", expected: true},
+		{name: "different paths", old: "https://www.example.com/a", new: "https://www.example.com/b", expected: false},
+		{name: "different path case", old: "https://www.example.com/path", new: "https://www.example.com/PATH", expected: false},
+		{name: "different path case without a scheme", old: "example.com/Foo", new: "example.com/foo", expected: false},
+		{name: "different host case without a scheme", old: "Example.com/Foo", new: "example.com/Foo", expected: true},
+		{name: "different schemes", old: "http://www.example.com/", new: "https://www.example.com/", expected: false},
+		{name: "non-default port", old: "https://www.example.com/", new: "https://www.example.com:8443/", expected: false},
+		{name: "different hosts", old: "203.0.113.1", new: "203.0.113.2", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := suppressEquivalentAddress("", tt.old, tt.new, nil); actual != tt.expected {
+				t.Errorf("expected %t but got %t", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestFlattenAddresses(t *testing.T) {
+	tests := []struct {
+		name       string
+		configured []interface{}
+		addresses  []string
+		expected   interface{}
+	}{
+		{
+			name:       "returns the configured addresses when equivalent",
+			configured: []interface{}{"https://www.example.com", "2001:db8::1"},
+			addresses:  []string{"2001:0db8::1", "https://www.example.com/"},
+			expected:   []interface{}{"2001:db8::1", "https://www.example.com"},
+		},
+		{
+			name:       "returns the API addresses when different",
+			configured: []interface{}{"https://www.example.com"},
+			addresses:  []string{"https://www.example.org/"},
+			expected:   []string{"https://www.example.org/"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configured := schema.NewSet(schema.HashString, tt.configured)
+			if actual := flattenAddresses(configured, tt.addresses); !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("expected %#v but got %#v", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/StatusCakeDev/statuscake-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	intdiag "github.com/StatusCakeDev/terraform-provider-statuscake/v2/internal/provider/diag"
+)
+
+// adoptExistingSchema returns the schema describing whether an existing object
+// is adopted rather than a duplicate being created. Since checks and contact
+// groups support adoption its structure has been encapsulated within a
+// function.
+func adoptExistingSchema(match string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: fmt.Sprintf("Whether to adopt an existing object with the same %s when the resource is created, rather than creating a duplicate. The adopted object is updated to match the configuration, with attributes omitted from the configuration set to their default values. Creation fails if more than one object matches", match),
+	}
+}
+
+// hasChange reports whether any of the given keys has changed. Every key is
+// reported as changed when creating a resource that may adopt an existing
+// object, so that the adopted object is updated with every configured and
+// default value rather than only those differing from their zero value.
+func hasChange(d *schema.ResourceData, keys ...string) bool {
+	if d.IsNewResource() && d.Get("adopt_existing").(bool) {
+		return true
+	}
+
+	return d.HasChanges(keys...)
+}
+
+// createOrAdopt creates an object with the given body, unless adoption is
+// enabled and find returns the ID of an existing object, in which case that
+// object is updated with the body instead. The ID of the created or adopted
+// object is set.
+func createOrAdopt(d *schema.ResourceData, kind string, body map[string]interface{}, find func() (string, error), create func() (string, error), update func(string) error) diag.Diagnostics {
+	if d.Get("adopt_existing").(bool) {
+		id, err := find()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if id != "" {
+			log.Printf("[DEBUG] Adopting existing StatusCake %s with ID: %s", kind, id)
+			log.Printf("[DEBUG] Request body: %+v", body)
+
+			if err := update(id); err != nil {
+				return intdiag.FromErr(fmt.Sprintf("failed to update %s with id %s", kind, id), err)
+			}
+
+			d.SetId(id)
+			return nil
+		}
+	}
+
+	log.Printf("[DEBUG] Creating StatusCake %s", kind)
+	log.Printf("[DEBUG] Request body: %+v", body)
+
+	id, err := create()
+	if err != nil {
+		return intdiag.FromErr(fmt.Sprintf("failed to create %s", kind), err)
+	}
+
+	d.SetId(id)
+	return nil
+}
+
+// adoptableID returns the ID of the only matching object. An empty string is
+// returned when no objects match, and an error when the match is ambiguous.
+func adoptableID(kind, match string, ids []string) (string, error) {
+	switch len(ids) {
+	case 0:
+		return "", nil
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("cannot adopt existing %s, found %d with %s: %s", kind, len(ids), match, strings.Join(ids, ", "))
+	}
+}
+
+// findUptimeCheck returns the ID of the uptime check to adopt with the given
+// name and test type.
+func findUptimeCheck(ctx context.Context, client *statuscake.Client, name, testType string) (string, error) {
+	checks, err := listUptimeChecks(ctx, client)
+	if err != nil {
+		return "", err
+	}
+
+	var ids []string
+	for _, check := range checks {
+		if check.Name == name && string(check.TestType) == testType {
+			ids = append(ids, check.ID)
+		}
+	}
+
+	return adoptableID("uptime check", fmt.Sprintf("name %q and type %s", name, testType), ids)
+}
+
+// findSSLCheck returns the ID of the SSL check to adopt with the given address.
+// Since SSL checks are not named they are matched by address.
+func findSSLCheck(ctx context.Context, client *statuscake.Client, address string) (string, error) {
+	checks, err := listSSLChecks(ctx, client)
+	if err != nil {
+		return "", err
+	}
+
+	var ids []string
+	for _, check := range checks {
+		if normalizeAddress(check.WebsiteURL) == normalizeAddress(address) {
+			ids = append(ids, check.ID)
+		}
+	}
+
+	return adoptableID("SSL check", fmt.Sprintf("address %q", address), ids)
+}
+
+// findPagespeedCheck returns the ID of the pagespeed check to adopt with the
+// given name.
+func findPagespeedCheck(ctx context.Context, client *statuscake.Client, name string) (string, error) {
+	checks, err := listPagespeedChecks(ctx, client)
+	if err != nil {
+		return "", err
+	}
+
+	var ids []string
+	for _, check := range checks {
+		if check.Name == name {
+			ids = append(ids, check.ID)
+		}
+	}
+
+	return adoptableID("pagespeed check", fmt.Sprintf("name %q", name), ids)
+}
+
+// findHeartbeatCheck returns the ID of the heartbeat check to adopt with the
+// given name.
+func findHeartbeatCheck(ctx context.Context, client *statuscake.Client, name string) (string, error) {
+	checks, err := listHeartbeatChecks(ctx, client)
+	if err != nil {
+		return "", err
+	}
+
+	var ids []string
+	for _, check := range checks {
+		if check.Name == name {
+			ids = append(ids, check.ID)
+		}
+	}
+
+	return adoptableID("heartbeat check", fmt.Sprintf("name %q", name), ids)
+}
+
+// findContactGroup returns the ID of the contact group to adopt with the given
+// name.
+func findContactGroup(ctx context.Context, client *statuscake.Client, name string) (string, error) {
+	groups, err := listContactGroups(ctx, client)
+	if err != nil {
+		return "", err
+	}
+
+	var ids []string
+	for _, group := range groups {
+		if group.Name == name {
+			ids = append(ids, group.ID)
+		}
+	}
+
+	return adoptableID("contact group", fmt.Sprintf("name %q", name), ids)
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestAdoptableID(t *testing.T) {
+	tests := []struct {
+		name     string
+		ids      []string
+		expected string
+		err      string
+	}{
+		{
+			name:     "returns an empty ID when nothing matches",
+			ids:      nil,
+			expected: "",
+		},
+		{
+			name:     "returns the ID of the only match",
+			ids:      []string{"1"},
+			expected: "1",
+		},
+		{
+			name: "returns an error when more than one object matches",
+			ids:  []string{"1", "2"},
+			err:  `cannot adopt existing uptime check, found 2 with name "Website": 1, 2`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := adoptableID("uptime check", `name "Website"`, tt.ids)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("expected error %q but got: %v", tt.err, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+
+			if id != tt.expected {
+				t.Errorf("expected %q but got %q", tt.expected, id)
+			}
+		})
+	}
+}
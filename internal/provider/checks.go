@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/StatusCakeDev/statuscake-go"
+)
+
+// uptimeCheckCache caches the listing of uptime checks for the lifetime of the
+// provider process so that reading many maintenance windows lists the uptime
+// checks only once. Since the tags of uptime checks determine which checks a
+// maintenance window covers, the listing is discarded whenever an uptime check
+// is created, updated, or deleted.
+type uptimeCheckCache struct {
+	mu     sync.Mutex
+	listed bool
+	checks []statuscake.UptimeTestOverview
+}
+
+func newUptimeCheckCache() *uptimeCheckCache {
+	return &uptimeCheckCache{}
+}
+
+// List returns every uptime check within the account.
+func (c *uptimeCheckCache) List(ctx context.Context, client *statuscake.Client) ([]statuscake.UptimeTestOverview, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.listed {
+		checks, err := listUptimeChecks(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+
+		c.checks = checks
+		c.listed = true
+	}
+
+	return c.checks, nil
+}
+
+// Invalidate discards the cached listing.
+func (c *uptimeCheckCache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.listed = false
+	c.checks = nil
+}
+
+// listUptimeChecks returns every uptime check within the account.
+func listUptimeChecks(ctx context.Context, client *statuscake.Client) ([]statuscake.UptimeTestOverview, error) {
+	var checks []statuscake.UptimeTestOverview
+	for page := int32(1); ; page++ {
+		res, err := client.ListUptimeTests(ctx).Page(page).Limit(100).Execute()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list uptime checks: %w", err)
+		}
+
+		checks = append(checks, res.Data...)
+		if page >= res.Metadata.PageCount {
+			return checks, nil
+		}
+	}
+}
+
+// listUptimeCheckHistory returns every result of the uptime check recorded
+// since the given time. The history is paged by time, so each subsequent page
+// is requested from before the oldest result of the previous page.
+func listUptimeCheckHistory(ctx context.Context, client *statuscake.Client, id string, since time.Time) ([]statuscake.UptimeTestHistoryResult, error) {
+	const limit = 100
+
+	var results []statuscake.UptimeTestHistoryResult
+	req := client.ListUptimeTestHistory(ctx, id).After(since.Unix()).Limit(limit)
+	for before := int64(0); ; {
+		res, err := req.Execute()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list uptime check history: %w", err)
+		}
+
+		results = append(results, res.Data...)
+		if len(res.Data) < limit {
+			return results, nil
+		}
+
+		oldest := res.Data[0].Created.Unix()
+		for _, result := range res.Data {
+			oldest = min(oldest, result.Created.Unix())
+		}
+
+		// Stop rather than request the same page again should every result
+		// have been recorded within the same second.
+		if before != 0 && oldest >= before {
+			return results, nil
+		}
+
+		before = oldest
+		req = req.Before(before)
+	}
+}
+
+// listSSLChecks returns every SSL check within the account.
+func listSSLChecks(ctx context.Context, client *statuscake.Client) ([]statuscake.SSLTest, error) {
+	var checks []statuscake.SSLTest
+	for page := int32(1); ; page++ {
+		res, err := client.ListSslTests(ctx).Page(page).Limit(100).Execute()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list SSL checks: %w", err)
+		}
+
+		checks = append(checks, res.Data...)
+		if page >= res.Metadata.PageCount {
+			return checks, nil
+		}
+	}
+}
+
+// listPagespeedChecks returns every pagespeed check within the account.
+func listPagespeedChecks(ctx context.Context, client *statuscake.Client) ([]statuscake.PagespeedTest, error) {
+	var checks []statuscake.PagespeedTest
+	for page := int32(1); ; page++ {
+		res, err := client.ListPagespeedTests(ctx).Page(page).Limit(100).Execute()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list pagespeed checks: %w", err)
+		}
+
+		checks = append(checks, res.Data...)
+		if page >= res.Metadata.PageCount {
+			return checks, nil
+		}
+	}
+}
+
+// listHeartbeatChecks returns every heartbeat check within the account.
+func listHeartbeatChecks(ctx context.Context, client *statuscake.Client) ([]statuscake.HeartbeatTestOverview, error) {
+	var checks []statuscake.HeartbeatTestOverview
+	for page := int32(1); ; page++ {
+		res, err := client.ListHeartbeatTests(ctx).Page(page).Limit(100).Execute()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list heartbeat checks: %w", err)
+		}
+
+		checks = append(checks, res.Data...)
+		if page >= res.Metadata.PageCount {
+			return checks, nil
+		}
+	}
+}
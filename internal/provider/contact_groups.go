@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/StatusCakeDev/statuscake-go"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// contactGroupCache records which contact groups exist within the account.
+// All contact groups are listed on first use so that validating a large plan
+// requires few API requests. Contact groups not present in the listing, such
+// as those created after the cache was populated, are looked up individually.
+type contactGroupCache struct {
+	mu     sync.Mutex
+	listed bool
+	exists map[string]bool
+}
+
+func newContactGroupCache() *contactGroupCache {
+	return &contactGroupCache{
+		exists: make(map[string]bool),
+	}
+}
+
+// Exists reports whether the contact group with the given ID exists.
+func (c *contactGroupCache) Exists(ctx context.Context, client *statuscake.Client, id string) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.listed {
+		groups, err := listContactGroups(ctx, client)
+		if err != nil {
+			return false, err
+		}
+
+		for _, group := range groups {
+			c.exists[group.ID] = true
+		}
+		c.listed = true
+	}
+
+	if exists, ok := c.exists[id]; ok {
+		return exists, nil
+	}
+
+	log.Printf("[DEBUG] Contact group with ID %s not found in cache", id)
+
+	_, err := client.GetContactGroup(ctx, id).Execute()
+	if isNotFound(err) {
+		c.exists[id] = false
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get contact group with ID: %s, error: %w", id, err)
+	}
+
+	c.exists[id] = true
+	return true, nil
+}
+
+// listContactGroups returns every contact group within the account.
+func listContactGroups(ctx context.Context, client *statuscake.Client) ([]statuscake.ContactGroup, error) {
+	var groups []statuscake.ContactGroup
+	for page := int32(1); ; page++ {
+		res, err := client.ListContactGroups(ctx).Page(page).Limit(100).Execute()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list contact groups: %w", err)
+		}
+
+		groups = append(groups, res.Data...)
+		if page >= res.Metadata.PageCount {
+			return groups, nil
+		}
+	}
+}
+
+// contactGroupsDiff ensures each contact group referenced by a check exists.
+// Contact group IDs that are not yet known, such as those of contact groups
+// created within the same plan, are not validated, though any other IDs within
+// the same set are. Validation only occurs when enabled within the provider
+// configuration.
+func contactGroupsDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	config := meta.(*providerConfig)
+	if config.contactGroups == nil || !d.HasChange("contact_groups") {
+		return nil
+	}
+
+	var errs []error
+	for _, id := range knownContactGroupIDs(d.GetRawConfig().GetAttr("contact_groups")) {
+		exists, err := config.contactGroups.Exists(ctx, config.client, id)
+		if err != nil {
+			return err
+		}
+
+		if !exists {
+			errs = append(errs, fmt.Errorf("contact_groups: contact group with ID %q does not exist", id))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// knownContactGroupIDs returns the contact group IDs within the configured set
+// whose values are known. Unknown and null elements are skipped, as is the
+// whole set when it is unknown or null.
+func knownContactGroupIDs(v cty.Value) []string {
+	if v.IsNull() || !v.IsKnown() || !v.CanIterateElements() {
+		return nil
+	}
+
+	var ids []string
+	for it := v.ElementIterator(); it.Next(); {
+		_, id := it.Element()
+		if id.IsNull() || !id.IsKnown() {
+			continue
+		}
+		ids = append(ids, id.AsString())
+	}
+
+	return ids
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestKnownContactGroupIDs(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    cty.Value
+		expected []string
+	}{
+		{
+			name:     "returns every known ID",
+			value:    cty.SetVal([]cty.Value{cty.StringVal("1"), cty.StringVal("2")}),
+			expected: []string{"1", "2"},
+		},
+		{
+			name:     "skips unknown IDs",
+			value:    cty.SetVal([]cty.Value{cty.StringVal("1"), cty.UnknownVal(cty.String)}),
+			expected: []string{"1"},
+		},
+		{
+			name:     "returns nothing when the set is unknown",
+			value:    cty.UnknownVal(cty.Set(cty.String)),
+			expected: nil,
+		},
+		{
+			name:     "returns nothing when the set is null",
+			value:    cty.NullVal(cty.Set(cty.String)),
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := knownContactGroupIDs(tt.value); !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("expected %v but got %v", tt.expected, actual)
+			}
+		})
+	}
+}
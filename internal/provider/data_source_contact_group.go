@@ -2,9 +2,7 @@ package provider
 
 import (
 	"context"
-	"net/http"
 
-	"github.com/StatusCakeDev/statuscake-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -61,13 +59,13 @@ func dataSourceStatusCakeContactGroup() *schema.Resource {
 }
 
 func dataSourceStatusCakeContactGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*statuscake.Client)
+	client := meta.(*providerConfig).client
 	id := d.Get("id").(string)
 
 	res, err := client.GetContactGroup(ctx, id).Execute()
 
 	// If the resource is not found then remove it from the state.
-	if err, ok := err.(statuscake.APIError); ok && err.Status == http.StatusNotFound {
+	if isNotFound(err) {
 		d.SetId("")
 		return nil
 	}
@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/StatusCakeDev/statuscake-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	intvalidation "github.com/StatusCakeDev/terraform-provider-statuscake/v2/internal/provider/validation"
+)
+
+func dataSourceStatusCakeMaintenanceWindows() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceStatusCakeMaintenanceWindowsRead,
+
+		Schema: map[string]*schema.Schema{
+			"state": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only include maintenance windows that are `pending`, `active`, or have `ended`",
+				ValidateFunc: validation.StringInSlice([]string{
+					maintenanceWindowStateActive,
+					maintenanceWindowStateEnded,
+					maintenanceWindowStatePending,
+				}, false),
+			},
+			"tag": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only include maintenance windows with the given tag",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"test_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only include maintenance windows that explicitly include the given uptime check ID",
+				ValidateFunc: intvalidation.StringIsNumerical,
+			},
+			"maintenance_windows": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of maintenance windows",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"end": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "End of the maintenance window (RFC3339 format)",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Maintenance window ID",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the maintenance window",
+						},
+						"next_end": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "End of the current or next occurrence of the maintenance window (RFC3339 format)",
+						},
+						"next_start": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Start of the current or next occurrence of the maintenance window (RFC3339 format)",
+						},
+						"repeat_interval": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "How often the maintenance window should occur",
+						},
+						"start": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Start of the maintenance window (RFC3339 format)",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Whether the maintenance window is `pending`, `active`, or has `ended`",
+						},
+						"tags": {
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Description: "List of tags used to include matching uptime checks in this maintenance window",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"tests": {
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Description: "List of uptime check IDs explicitly included in this maintenance window",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"timezone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Standard timezone associated with this maintenance window",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceStatusCakeMaintenanceWindowsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	windows, err := listMaintenanceWindows(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	state := d.Get("state").(string)
+	tag := d.Get("tag").(string)
+	testID := d.Get("test_id").(string)
+	now := time.Now()
+
+	var filtered []interface{}
+	for _, window := range windows {
+		if tag != "" && !slices.Contains(window.Tags, tag) {
+			continue
+		}
+
+		if testID != "" && !slices.Contains(window.Tests, testID) {
+			continue
+		}
+
+		flattened := flattenMaintenanceWindowsWindow(window, now)
+		if state != "" && flattened["state"] != state {
+			continue
+		}
+
+		filtered = append(filtered, flattened)
+	}
+
+	if err := d.Set("maintenance_windows", filtered); err != nil {
+		return diag.Errorf("error setting maintenance windows: %s", err)
+	}
+
+	d.SetId(strconv.FormatInt(now.Unix(), 10))
+
+	return nil
+}
+
+// listMaintenanceWindows returns every maintenance window within the account.
+func listMaintenanceWindows(ctx context.Context, client *statuscake.Client) ([]statuscake.MaintenanceWindow, error) {
+	var windows []statuscake.MaintenanceWindow
+	for page := int32(1); ; page++ {
+		res, err := client.ListMaintenanceWindows(ctx).Page(page).Limit(100).Execute()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list maintenance windows: %w", err)
+		}
+
+		windows = append(windows, res.Data...)
+		if page >= res.Metadata.PageCount {
+			return windows, nil
+		}
+	}
+}
+
+func flattenMaintenanceWindowsWindow(w statuscake.MaintenanceWindow, now time.Time) map[string]interface{} {
+	state, nextStart, nextEnd := maintenanceWindowSchedule(w, now)
+
+	return map[string]interface{}{
+		"end":             w.End.Format(time.RFC3339),
+		"id":              w.ID,
+		"name":            w.Name,
+		"next_end":        flattenMaintenanceWindowScheduleTime(nextEnd),
+		"next_start":      flattenMaintenanceWindowScheduleTime(nextStart),
+		"repeat_interval": string(w.RepeatInterval),
+		"start":           w.Start.Format(time.RFC3339),
+		"state":           state,
+		"tags":            w.Tags,
+		"tests":           w.Tests,
+		"timezone":        w.Timezone,
+	}
+}
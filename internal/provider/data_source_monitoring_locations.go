@@ -76,7 +76,7 @@ func locationSchema() map[string]*schema.Schema {
 
 func dataSourceStatusCakeMonitoringLocationsRead(fn monitoringLocationsFunc) schema.ReadContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-		client := meta.(*statuscake.Client)
+		client := meta.(*providerConfig).client
 
 		res, err := fn(ctx, client, d.Get("region_code").(string))
 		if err != nil {
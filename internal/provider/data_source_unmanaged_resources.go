@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceStatusCakeUnmanagedResources() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceStatusCakeUnmanagedResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"managed": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "IDs of the objects managed by Terraform, by resource type. Objects of each resource type with any other ID are reported as unmanaged. Since objects of different types may share an ID, IDs only exclude objects of the given type",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ids": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							Description: "List of IDs of the managed objects",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Resource type managing the objects, such as `statuscake_uptime_check`",
+							ValidateFunc: validation.StringInSlice(exportResources, false),
+						},
+					},
+				},
+			},
+			"types": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Only include objects that would be managed by the given resource types, such as `statuscake_uptime_check`. By default every type is included",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(exportResources, false),
+				},
+			},
+			"groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Unmanaged objects grouped by resource type and tag. Objects with several tags are included within the group of each tag. Objects without tags are grouped under an empty tag",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "List of IDs of the unmanaged objects",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"tag": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Tag shared by the unmanaged objects",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Resource type that would manage the objects",
+						},
+					},
+				},
+			},
+			"resources": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of objects that are not managed by Terraform",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the object",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the object. SSL checks are named by their address",
+						},
+						"tags": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "List of tags",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Resource type that would manage the object",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceStatusCakeUnmanagedResourcesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*providerConfig).client
+
+	managed := expandUnmanagedResourcesManaged(d.Get("managed"))
+	types := convertStringSet(d.Get("types").(*schema.Set))
+	kinds := importKinds()
+
+	var resources, groups []interface{}
+	for _, resource := range exportResources {
+		if len(types) != 0 && !slices.Contains(types, resource) {
+			continue
+		}
+
+		candidates, err := kinds[resource].list(ctx, client)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		slices.SortFunc(candidates, func(a, b importCandidate) int {
+			return strings.Compare(a.id, b.id)
+		})
+
+		byTag := make(map[string][]string)
+		for _, candidate := range candidates {
+			if _, ok := managed[resource][candidate.id]; ok {
+				continue
+			}
+
+			tags := candidate.values["tag"]
+			resources = append(resources, map[string]interface{}{
+				"id":   candidate.id,
+				"name": candidate.displayName(),
+				"tags": tags,
+				"type": resource,
+			})
+
+			if len(tags) == 0 {
+				byTag[""] = append(byTag[""], candidate.id)
+			}
+			for _, tag := range tags {
+				byTag[tag] = append(byTag[tag], candidate.id)
+			}
+		}
+
+		tags := make([]string, 0, len(byTag))
+		for tag := range byTag {
+			tags = append(tags, tag)
+		}
+		slices.Sort(tags)
+
+		for _, tag := range tags {
+			groups = append(groups, map[string]interface{}{
+				"ids":  byTag[tag],
+				"tag":  tag,
+				"type": resource,
+			})
+		}
+	}
+
+	if err := d.Set("resources", resources); err != nil {
+		return diag.Errorf("error setting unmanaged resources: %s", err)
+	}
+
+	if err := d.Set("groups", groups); err != nil {
+		return diag.Errorf("error setting unmanaged resource groups: %s", err)
+	}
+
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return nil
+}
+
+// expandUnmanagedResourcesManaged returns the IDs of the managed objects keyed
+// by resource type.
+func expandUnmanagedResourcesManaged(v interface{}) map[string]map[string]struct{} {
+	managed := make(map[string]map[string]struct{})
+	for _, block := range v.([]interface{}) {
+		m, ok := block.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		resource := m["type"].(string)
+		if managed[resource] == nil {
+			managed[resource] = make(map[string]struct{})
+		}
+
+		for _, id := range convertStringSet(m["ids"].(*schema.Set)) {
+			managed[resource][id] = struct{}{}
+		}
+	}
+	return managed
+}
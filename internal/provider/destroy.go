@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	onDestroyDelete = "delete"
+	onDestroyPause  = "pause"
+)
+
+// deletionProtectionSchema returns the schema describing whether a check may be
+// deleted. Since every check type supports deletion protection its structure
+// has been encapsulated within a function.
+func deletionProtectionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Whether to prevent the check from being destroyed. When enabled any plan that destroys or replaces the check fails to apply",
+	}
+}
+
+// onDestroySchema returns the schema describing what happens to a check when
+// it is destroyed. Since every check type supports this behaviour its
+// structure has been encapsulated within a function.
+func onDestroySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      onDestroyDelete,
+		Description:  "What happens to the check when it is destroyed. Either `delete` to delete the check, or `pause` to pause the check and retain its history without deleting it",
+		ValidateFunc: validation.StringInSlice([]string{onDestroyDelete, onDestroyPause}, false),
+	}
+}
+
+// checkDeletion returns an error if the check is protected from deletion.
+// Otherwise it reports whether the check should be paused rather than deleted.
+func checkDeletion(d *schema.ResourceData, kind string) (bool, diag.Diagnostics) {
+	if d.Get("deletion_protection").(bool) {
+		return false, diag.Errorf("cannot destroy %s with ID: %s, deletion_protection is enabled", kind, d.Id())
+	}
+	return d.Get("on_destroy").(string) == onDestroyPause, nil
+}
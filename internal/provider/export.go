@@ -0,0 +1,400 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	hclcty "github.com/zclconf/go-cty/cty"
+)
+
+// exportResources are the resource types that are exported, in the order in
+// which they are written.
+var exportResources = []string{
+	"statuscake_contact_group",
+	"statuscake_uptime_check",
+	"statuscake_ssl_check",
+	"statuscake_pagespeed_check",
+	"statuscake_heartbeat_check",
+	"statuscake_maintenance_window",
+}
+
+// exportReferences maps attributes holding the IDs of other objects to the
+// resource type of those objects. Exported IDs are written as references to
+// the exported resources.
+var exportReferences = map[string]string{
+	"contact_groups": "statuscake_contact_group",
+	"tests":          "statuscake_uptime_check",
+}
+
+// exportIgnored are attributes that do not describe the remote object and are
+// never exported.
+var exportIgnored = []string{
+	"manage_paused",
+}
+
+var invalidLabelChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// exportObject is an object to be exported as a resource.
+type exportObject struct {
+	resource string
+	id       string
+	label    string
+	data     *schema.ResourceData
+}
+
+// Export writes configuration for every check, contact group, and maintenance
+// window within the account to w, alongside import blocks that bring each
+// object under management. The provider is configured from the environment.
+func Export(ctx context.Context, w io.Writer) error {
+	p := Provider()
+	if diags := p.Configure(ctx, terraform.NewResourceConfigRaw(map[string]interface{}{})); diags.HasError() {
+		return diagsError(diags)
+	}
+
+	meta := p.Meta().(*providerConfig)
+	kinds := importKinds()
+
+	var objects []exportObject
+	labels := make(map[string]map[string]string)
+	for _, resource := range exportResources {
+		log.Printf("[DEBUG] Listing objects to export into %s", resource)
+
+		candidates, err := kinds[resource].list(ctx, meta.client)
+		if err != nil {
+			return err
+		}
+
+		slices.SortFunc(candidates, func(a, b importCandidate) int {
+			return strings.Compare(a.id, b.id)
+		})
+
+		labels[resource] = make(map[string]string)
+		used := make(map[string]bool)
+		for _, candidate := range candidates {
+			r := p.ResourcesMap[resource]
+
+			d, err := readExportObject(ctx, r, candidate.id, meta)
+			if err != nil {
+				return err
+			}
+
+			// Objects deleted since being listed are not exported.
+			if d.Id() == "" {
+				continue
+			}
+
+			label := exportLabel(candidate, used)
+			labels[resource][candidate.id] = label
+			objects = append(objects, exportObject{
+				resource: resource,
+				id:       candidate.id,
+				label:    label,
+				data:     d,
+			})
+		}
+	}
+
+	return writeExport(w, p.ResourcesMap, objects, labels)
+}
+
+// writeExport writes a resource block and an import block for each object to
+// w. The labels of the exported objects, keyed by resource type and ID, are
+// used to write references between them.
+func writeExport(w io.Writer, resources map[string]*schema.Resource, objects []exportObject, labels map[string]map[string]string) error {
+	f := hclwrite.NewEmptyFile()
+	for i, object := range objects {
+		r := resources[object.resource]
+
+		if i > 0 {
+			f.Body().AppendNewline()
+		}
+
+		block := f.Body().AppendNewBlock("resource", []string{object.resource, object.label})
+		writeExportBody(block.Body(), r.SchemaMap(), exportValues(r.SchemaMap(), object.data), labels)
+		f.Body().AppendNewline()
+
+		imp := f.Body().AppendNewBlock("import", nil)
+		imp.Body().SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: object.resource},
+			hcl.TraverseAttr{Name: object.label},
+		})
+		imp.Body().SetAttributeValue("id", hclcty.StringVal(object.id))
+	}
+
+	_, err := f.WriteTo(w)
+	return err
+}
+
+// readExportObject reads the object with the given ID as though it were being
+// imported.
+func readExportObject(ctx context.Context, r *schema.Resource, id string, meta interface{}) (*schema.ResourceData, error) {
+	ty := r.CoreConfigSchema().ImpliedType()
+
+	attrs := make(map[string]cty.Value)
+	for name, t := range ty.AttributeTypes() {
+		attrs[name] = cty.NullVal(t)
+	}
+
+	d := r.Data(&terraform.InstanceState{
+		ID:         id,
+		Attributes: map[string]string{"id": id},
+		RawState:   cty.ObjectVal(attrs),
+	})
+
+	if diags := r.ReadContext(ctx, d, meta); diags.HasError() {
+		return nil, diagsError(diags)
+	}
+	return d, nil
+}
+
+// exportValues returns the value of each attribute of the object.
+func exportValues(s map[string]*schema.Schema, d *schema.ResourceData) map[string]interface{} {
+	values := make(map[string]interface{}, len(s))
+	for key := range s {
+		values[key] = d.Get(key)
+	}
+	return values
+}
+
+// writeExportBody writes the configurable attributes and blocks to body.
+// Attributes with the value used when the attribute is omitted are themselves
+// omitted.
+func writeExportBody(body *hclwrite.Body, s map[string]*schema.Schema, values map[string]interface{}, labels map[string]map[string]string) {
+	keys := make([]string, 0, len(s))
+	for key := range s {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	var blocks []string
+	for _, key := range keys {
+		sch := s[key]
+		if !isExportedAttribute(key, sch) {
+			continue
+		}
+
+		if _, ok := sch.Elem.(*schema.Resource); ok {
+			blocks = append(blocks, key)
+			continue
+		}
+
+		v := values[key]
+
+		// Sensitive values are not returned by the API so must be added to the
+		// configuration by hand.
+		if sch.Sensitive && !sch.WriteOnly {
+			if isDefaultExportValue(sch, v) && !sch.Required && len(sch.ExactlyOneOf) == 0 {
+				continue
+			}
+
+			body.AppendUnstructuredTokens(hclwrite.Tokens{{
+				Type:  hclsyntax.TokenComment,
+				Bytes: []byte(fmt.Sprintf("# %s is sensitive and has not been exported\n", key)),
+			}})
+			continue
+		}
+
+		if isDefaultExportValue(sch, v) {
+			continue
+		}
+
+		if resource, ok := exportReferences[key]; ok {
+			body.SetAttributeRaw(key, exportReferenceTokens(exportList(v), labels[resource], resource))
+			continue
+		}
+
+		body.SetAttributeValue(key, exportCtyValue(v))
+	}
+
+	for _, key := range blocks {
+		elem := s[key].Elem.(*schema.Resource)
+		for _, v := range exportList(values[key]) {
+			// Blocks without any attributes, such as icmp_check, are nil.
+			m, _ := v.(map[string]interface{})
+
+			block := body.AppendNewBlock(key, nil)
+			writeExportBody(block.Body(), elem.SchemaMap(), m, labels)
+		}
+	}
+}
+
+// isExportedAttribute reports whether the attribute may be configured and
+// describes the remote object.
+func isExportedAttribute(key string, s *schema.Schema) bool {
+	if s.Computed && !s.Optional && !s.Required {
+		return false
+	}
+	return s.Deprecated == "" && !slices.Contains(exportIgnored, key)
+}
+
+// exportReferenceTokens returns a list of IDs, writing each exported ID as a
+// reference to the id attribute of the exported resource.
+func exportReferenceTokens(ids []interface{}, labels map[string]string, resource string) hclwrite.Tokens {
+	elems := make([]hclwrite.Tokens, 0, len(ids))
+	for _, id := range ids {
+		label, ok := labels[fmt.Sprint(id)]
+		if !ok {
+			elems = append(elems, hclwrite.TokensForValue(exportCtyValue(id)))
+			continue
+		}
+
+		elems = append(elems, hclwrite.TokensForTraversal(hcl.Traversal{
+			hcl.TraverseRoot{Name: resource},
+			hcl.TraverseAttr{Name: label},
+			hcl.TraverseAttr{Name: "id"},
+		}))
+	}
+	return hclwrite.TokensForTuple(elems)
+}
+
+// exportList returns the elements of a list or set.
+func exportList(v interface{}) []interface{} {
+	switch v := v.(type) {
+	case []interface{}:
+		return v
+	case *schema.Set:
+		// Sets are sorted so that the configuration is stable.
+		list := v.List()
+		slices.SortFunc(list, func(a, b interface{}) int {
+			if a, ok := a.(int); ok {
+				if b, ok := b.(int); ok {
+					return cmp.Compare(a, b)
+				}
+			}
+			return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+		})
+		return list
+	default:
+		return nil
+	}
+}
+
+// exportCtyValue returns the HCL value of an attribute.
+func exportCtyValue(v interface{}) hclcty.Value {
+	switch v := v.(type) {
+	case string:
+		return hclcty.StringVal(v)
+	case int:
+		return hclcty.NumberIntVal(int64(v))
+	case float64:
+		return hclcty.NumberFloatVal(v)
+	case bool:
+		return hclcty.BoolVal(v)
+	case []interface{}, *schema.Set:
+		elems := exportList(v)
+		if len(elems) == 0 {
+			return hclcty.EmptyTupleVal
+		}
+
+		vals := make([]hclcty.Value, len(elems))
+		for i, elem := range elems {
+			vals[i] = exportCtyValue(elem)
+		}
+		return hclcty.TupleVal(vals)
+	case map[string]interface{}:
+		if len(v) == 0 {
+			return hclcty.EmptyObjectVal
+		}
+
+		vals := make(map[string]hclcty.Value, len(v))
+		for key, elem := range v {
+			vals[key] = exportCtyValue(elem)
+		}
+		return hclcty.ObjectVal(vals)
+	default:
+		return hclcty.StringVal(fmt.Sprint(v))
+	}
+}
+
+// isDefaultExportValue reports whether the value is that used when the
+// attribute is omitted. This is the default of the attribute if it has one, and
+// otherwise the zero value of its type.
+func isDefaultExportValue(s *schema.Schema, v interface{}) bool {
+	if v == nil {
+		return true
+	}
+	if s.Default != nil {
+		return fmt.Sprint(s.Default) == fmt.Sprint(v)
+	}
+	return isZeroExportValue(v)
+}
+
+// isZeroExportValue reports whether the value is the zero value of its type.
+func isZeroExportValue(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case bool:
+		return !v
+	case []interface{}:
+		return len(v) == 0
+	case *schema.Set:
+		return v.Len() == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
+
+// exportLabel returns a unique resource name derived from the name or address
+// of the object.
+func exportLabel(candidate importCandidate, used map[string]bool) string {
+	name := candidate.displayName()
+	if u, err := url.Parse(name); err == nil && u.Hostname() != "" {
+		name = u.Hostname()
+	}
+
+	label := strings.Trim(invalidLabelChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if label == "" {
+		label = "id_" + candidate.id
+	} else if label[0] >= '0' && label[0] <= '9' {
+		label = "_" + label
+	}
+
+	unique := label
+	for i := 2; used[unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", label, i)
+	}
+	used[unique] = true
+
+	return unique
+}
+
+// diagsError returns the errors within the diagnostics as a single error.
+func diagsError(diags diag.Diagnostics) error {
+	var errs []error
+	for _, d := range diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+
+		if d.Detail != "" {
+			errs = append(errs, fmt.Errorf("%s: %s", d.Summary, d.Detail))
+		} else {
+			errs = append(errs, errors.New(d.Summary))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,448 @@
+package provider
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// exportTestLabels are the labels of the objects that may be referenced by the
+// exported objects, keyed by resource type and ID.
+var exportTestLabels = map[string]map[string]string{
+	"statuscake_contact_group": {"10": "on_call"},
+	"statuscake_uptime_check":  {"20": "website"},
+}
+
+// exportTest describes the configuration expected to be written when
+// exporting an object read with the given values.
+type exportTest struct {
+	name     string
+	resource string
+	values   map[string]interface{}
+	expected string
+}
+
+// exportTestData returns the data of an object as it would be read, with the
+// given values and the default value of every other attribute.
+func exportTestData(t *testing.T, r *schema.Resource, id string, values map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+
+	d := r.Data(nil)
+	d.SetId(id)
+	for key, v := range withSchemaDefaults(r.SchemaMap(), values) {
+		if err := d.Set(key, v); err != nil {
+			t.Fatalf("failed to set %s: %+v", key, err)
+		}
+	}
+	return d
+}
+
+func TestWriteExport(t *testing.T) {
+	resources := Provider().ResourcesMap
+
+	tests := []exportTest{
+		{
+			name:     "writes a contact group",
+			resource: "statuscake_contact_group",
+			values: map[string]interface{}{
+				"name":            "Operations",
+				"email_addresses": []interface{}{"picard@starfleet.com", "riker@starfleet.com"},
+				"ping_url":        "https://www.example.com/ping",
+			},
+			expected: `resource "statuscake_contact_group" "example" {
+  email_addresses = ["picard@starfleet.com", "riker@starfleet.com"]
+  name            = "Operations"
+  ping_url        = "https://www.example.com/ping"
+}
+
+import {
+  to = statuscake_contact_group.example
+  id = "1"
+}
+`,
+		},
+		{
+			name:     "writes an uptime HTTP check with references and sensitive attributes",
+			resource: "statuscake_uptime_check",
+			values: map[string]interface{}{
+				"name":           "Website",
+				"check_interval": "300",
+				"contact_groups": []interface{}{"10", "99"},
+				"manage_paused":  true,
+				"tags":           []interface{}{"production"},
+				"http_check": []interface{}{
+					map[string]interface{}{
+						"follow_redirects": true,
+						"status_codes":     []interface{}{"5xx", "404"},
+						"basic_authentication": []interface{}{
+							map[string]interface{}{
+								"username": "picard",
+							},
+						},
+						"content_matchers": []interface{}{
+							map[string]interface{}{
+								"content": "error",
+								"matcher": matcherNoContains,
+							},
+						},
+					},
+				},
+				"monitored_resource": []interface{}{
+					map[string]interface{}{
+						"address": "https://www.example.com",
+					},
+				},
+			},
+			expected: `resource "statuscake_uptime_check" "example" {
+  check_interval = "300"
+  contact_groups = [statuscake_contact_group.on_call.id, "99"]
+  name           = "Website"
+  tags           = ["production"]
+  http_check {
+    follow_redirects = true
+    status_codes     = ["404", "5xx"]
+    basic_authentication {
+      # password is sensitive and has not been exported
+      username = "picard"
+    }
+    content_matchers {
+      content = "error"
+      matcher = "NOT_CONTAINS_STRING"
+    }
+  }
+  monitored_resource {
+    address = "https://www.example.com"
+  }
+}
+
+import {
+  to = statuscake_uptime_check.example
+  id = "1"
+}
+`,
+		},
+		{
+			name:     "writes zero values that differ from the default",
+			resource: "statuscake_uptime_check",
+			values: map[string]interface{}{
+				"name":           "Server",
+				"check_interval": "60",
+				"confirmation":   0,
+				"icmp_check":     []interface{}{map[string]interface{}{}},
+				"monitored_resource": []interface{}{
+					map[string]interface{}{
+						"address": "203.0.113.1",
+					},
+				},
+			},
+			expected: `resource "statuscake_uptime_check" "example" {
+  check_interval = "60"
+  confirmation   = 0
+  name           = "Server"
+  icmp_check {
+  }
+  monitored_resource {
+    address = "203.0.113.1"
+  }
+}
+
+import {
+  to = statuscake_uptime_check.example
+  id = "1"
+}
+`,
+		},
+		{
+			name:     "writes an uptime TCP check",
+			resource: "statuscake_uptime_check",
+			values: map[string]interface{}{
+				"name":           "Mail",
+				"check_interval": "300",
+				"tcp_check": []interface{}{
+					map[string]interface{}{
+						"port":     25,
+						"protocol": "SMTP",
+						"timeout":  "30",
+					},
+				},
+				"monitored_resource": []interface{}{
+					map[string]interface{}{
+						"address": "mail.example.com",
+					},
+				},
+			},
+			expected: `resource "statuscake_uptime_check" "example" {
+  check_interval = "300"
+  name           = "Mail"
+  monitored_resource {
+    address = "mail.example.com"
+  }
+  tcp_check {
+    port     = 25
+    protocol = "SMTP"
+    timeout  = "30"
+  }
+}
+
+import {
+  to = statuscake_uptime_check.example
+  id = "1"
+}
+`,
+		},
+		{
+			name:     "writes an SSL check",
+			resource: "statuscake_ssl_check",
+			values: map[string]interface{}{
+				"check_interval": "86400",
+				"alert_config": []interface{}{
+					map[string]interface{}{
+						"alert_at":  []interface{}{30, 7, 14},
+						"on_expiry": true,
+					},
+				},
+				"monitored_resource": []interface{}{
+					map[string]interface{}{
+						"address": "https://www.example.com",
+					},
+				},
+			},
+			expected: `resource "statuscake_ssl_check" "example" {
+  check_interval = "86400"
+  alert_config {
+    alert_at  = [7, 14, 30]
+    on_expiry = true
+  }
+  monitored_resource {
+    address = "https://www.example.com"
+  }
+}
+
+import {
+  to = statuscake_ssl_check.example
+  id = "1"
+}
+`,
+		},
+		{
+			name:     "writes a pagespeed check",
+			resource: "statuscake_pagespeed_check",
+			values: map[string]interface{}{
+				"name":           "Homepage",
+				"check_interval": "3600",
+				"location":       "EU",
+				"region":         "UK",
+				"alert_config": []interface{}{
+					map[string]interface{}{
+						"alert_slower": 5000,
+					},
+				},
+				"monitored_resource": []interface{}{
+					map[string]interface{}{
+						"address": "https://www.example.com",
+					},
+				},
+			},
+			expected: `resource "statuscake_pagespeed_check" "example" {
+  check_interval = "3600"
+  name           = "Homepage"
+  region         = "UK"
+  alert_config {
+    alert_slower = 5000
+  }
+  monitored_resource {
+    address = "https://www.example.com"
+  }
+}
+
+import {
+  to = statuscake_pagespeed_check.example
+  id = "1"
+}
+`,
+		},
+		{
+			name:     "writes a heartbeat check",
+			resource: "statuscake_heartbeat_check",
+			values: map[string]interface{}{
+				"name":      "Backups",
+				"period":    "1800",
+				"paused":    true,
+				"check_url": "https://push.statuscake.com/?PK=abc",
+			},
+			expected: `resource "statuscake_heartbeat_check" "example" {
+  name   = "Backups"
+  paused = true
+  period = "1800"
+}
+
+import {
+  to = statuscake_heartbeat_check.example
+  id = "1"
+}
+`,
+		},
+		{
+			name:     "writes a maintenance window",
+			resource: "statuscake_maintenance_window",
+			values: map[string]interface{}{
+				"name":            "Weekly",
+				"start":           "2030-01-01T02:00:00Z",
+				"end":             "2030-01-01T04:00:00Z",
+				"repeat_interval": "1w",
+				"timezone":        "Europe/London",
+				"tests":           []interface{}{"20"},
+				"state":           "pending",
+			},
+			expected: `resource "statuscake_maintenance_window" "example" {
+  end             = "2030-01-01T04:00:00Z"
+  name            = "Weekly"
+  repeat_interval = "1w"
+  start           = "2030-01-01T02:00:00Z"
+  tests           = [statuscake_uptime_check.website.id]
+  timezone        = "Europe/London"
+}
+
+import {
+  to = statuscake_maintenance_window.example
+  id = "1"
+}
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := resources[tt.resource]
+			objects := []exportObject{{
+				resource: tt.resource,
+				id:       "1",
+				label:    "example",
+				data:     exportTestData(t, r, "1", tt.values),
+			}}
+
+			var buf bytes.Buffer
+			if err := writeExport(&buf, resources, objects, exportTestLabels); err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+
+			if actual := buf.String(); actual != tt.expected {
+				t.Errorf("unexpected configuration\nexpected:\n%s\nactual:\n%s", tt.expected, actual)
+			}
+		})
+	}
+
+	t.Run("separates each object with a blank line", func(t *testing.T) {
+		r := resources["statuscake_contact_group"]
+		objects := []exportObject{
+			{resource: "statuscake_contact_group", id: "1", label: "first", data: exportTestData(t, r, "1", map[string]interface{}{"name": "First"})},
+			{resource: "statuscake_contact_group", id: "2", label: "second", data: exportTestData(t, r, "2", map[string]interface{}{"name": "Second"})},
+		}
+
+		expected := `resource "statuscake_contact_group" "first" {
+  name = "First"
+}
+
+import {
+  to = statuscake_contact_group.first
+  id = "1"
+}
+
+resource "statuscake_contact_group" "second" {
+  name = "Second"
+}
+
+import {
+  to = statuscake_contact_group.second
+  id = "2"
+}
+`
+
+		var buf bytes.Buffer
+		if err := writeExport(&buf, resources, objects, exportTestLabels); err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+
+		if actual := buf.String(); actual != expected {
+			t.Errorf("unexpected configuration\nexpected:\n%s\nactual:\n%s", expected, actual)
+		}
+	})
+}
+
+func TestExportLabel(t *testing.T) {
+	tests := []struct {
+		name      string
+		candidate importCandidate
+		used      []string
+		expected  string
+	}{
+		{
+			name:      "derives the label from the name",
+			candidate: importCandidate{id: "1", values: map[string][]string{"name": {"My Website!"}}},
+			expected:  "my_website",
+		},
+		{
+			name:      "derives the label from the hostname of an address",
+			candidate: importCandidate{id: "1", values: map[string][]string{"address": {"https://www.example.com/path"}}},
+			expected:  "www_example_com",
+		},
+		{
+			name:      "prefixes labels starting with a digit",
+			candidate: importCandidate{id: "1", values: map[string][]string{"name": {"24/7 Support"}}},
+			expected:  "_24_7_support",
+		},
+		{
+			name:      "derives the label from the ID when the name has no valid characters",
+			candidate: importCandidate{id: "123", values: map[string][]string{"name": {"!!!"}}},
+			expected:  "id_123",
+		},
+		{
+			name:      "suffixes labels that have already been used",
+			candidate: importCandidate{id: "1", values: map[string][]string{"name": {"Website"}}},
+			used:      []string{"website", "website_2"},
+			expected:  "website_3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			used := make(map[string]bool)
+			for _, label := range tt.used {
+				used[label] = true
+			}
+
+			if actual := exportLabel(tt.candidate, used); actual != tt.expected {
+				t.Errorf("expected %q but got %q", tt.expected, actual)
+			}
+
+			if !used[tt.expected] {
+				t.Errorf("expected %q to be marked as used", tt.expected)
+			}
+		})
+	}
+}
+
+func TestIsDefaultExportValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		schema   *schema.Schema
+		value    interface{}
+		expected bool
+	}{
+		{name: "zero value without a default", schema: &schema.Schema{Type: schema.TypeInt}, value: 0, expected: true},
+		{name: "non-zero value without a default", schema: &schema.Schema{Type: schema.TypeInt}, value: 3, expected: false},
+		{name: "value equal to the default", schema: &schema.Schema{Type: schema.TypeInt, Default: 2}, value: 2, expected: true},
+		{name: "zero value differing from the default", schema: &schema.Schema{Type: schema.TypeInt, Default: 2}, value: 0, expected: false},
+		{name: "false differing from a true default", schema: &schema.Schema{Type: schema.TypeBool, Default: true}, value: false, expected: false},
+		{name: "missing value", schema: &schema.Schema{Type: schema.TypeString, Default: "15"}, value: nil, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := isDefaultExportValue(tt.schema, tt.value); actual != tt.expected {
+				t.Errorf("expected %t but got %t", tt.expected, actual)
+			}
+		})
+	}
+}
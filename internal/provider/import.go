@@ -0,0 +1,294 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/StatusCakeDev/statuscake-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// importCandidate is an object that may be selected by an import ID of the
+// form <key>=<value>. Each key maps to the values by which the object may be
+// selected.
+type importCandidate struct {
+	id     string
+	values map[string][]string
+}
+
+// displayName returns the name of the object. Since SSL checks are not named
+// their address is returned instead.
+func (c importCandidate) displayName() string {
+	if names := c.values["name"]; len(names) > 0 {
+		return names[0]
+	}
+	if addresses := c.values["address"]; len(addresses) > 0 {
+		return addresses[0]
+	}
+	return ""
+}
+
+// importKind describes how objects imported into a resource type are found.
+type importKind struct {
+	// name is the human readable name of the objects.
+	name string
+	// keys are the keys that may be used to select an object to import.
+	keys []string
+	// get returns an error if the object with the given ID cannot be imported.
+	get func(ctx context.Context, client *statuscake.Client, id string) error
+	// list returns every object that may be imported.
+	list func(ctx context.Context, client *statuscake.Client) ([]importCandidate, error)
+}
+
+// importKinds returns how objects are imported, keyed by resource type.
+func importKinds() map[string]importKind {
+	return map[string]importKind{
+		"statuscake_contact_group": {
+			name: "contact group",
+			keys: []string{"name"},
+			get: func(ctx context.Context, client *statuscake.Client, id string) error {
+				_, err := client.GetContactGroup(ctx, id).Execute()
+				return err
+			},
+			list: func(ctx context.Context, client *statuscake.Client) ([]importCandidate, error) {
+				groups, err := listContactGroups(ctx, client)
+				if err != nil {
+					return nil, err
+				}
+
+				candidates := make([]importCandidate, len(groups))
+				for i, group := range groups {
+					candidates[i] = importCandidate{
+						id:     group.ID,
+						values: map[string][]string{"name": {group.Name}},
+					}
+				}
+				return candidates, nil
+			},
+		},
+		"statuscake_heartbeat_check": {
+			name: "heartbeat check",
+			keys: []string{"name", "tag"},
+			get: func(ctx context.Context, client *statuscake.Client, id string) error {
+				_, err := client.GetHeartbeatTest(ctx, id).Execute()
+				return err
+			},
+			list: func(ctx context.Context, client *statuscake.Client) ([]importCandidate, error) {
+				checks, err := listHeartbeatChecks(ctx, client)
+				if err != nil {
+					return nil, err
+				}
+
+				candidates := make([]importCandidate, len(checks))
+				for i, check := range checks {
+					candidates[i] = importCandidate{
+						id:     check.ID,
+						values: map[string][]string{"name": {check.Name}, "tag": check.Tags},
+					}
+				}
+				return candidates, nil
+			},
+		},
+		"statuscake_maintenance_window": {
+			name: "maintenance window",
+			keys: []string{"name", "tag"},
+			get: func(ctx context.Context, client *statuscake.Client, id string) error {
+				_, err := client.GetMaintenanceWindow(ctx, id).Execute()
+				return err
+			},
+			list: func(ctx context.Context, client *statuscake.Client) ([]importCandidate, error) {
+				windows, err := listMaintenanceWindows(ctx, client)
+				if err != nil {
+					return nil, err
+				}
+
+				candidates := make([]importCandidate, len(windows))
+				for i, window := range windows {
+					candidates[i] = importCandidate{
+						id:     window.ID,
+						values: map[string][]string{"name": {window.Name}, "tag": window.Tags},
+					}
+				}
+				return candidates, nil
+			},
+		},
+		"statuscake_pagespeed_check": {
+			name: "pagespeed check",
+			keys: []string{"name"},
+			get: func(ctx context.Context, client *statuscake.Client, id string) error {
+				_, err := client.GetPagespeedTest(ctx, id).Execute()
+				return err
+			},
+			list: func(ctx context.Context, client *statuscake.Client) ([]importCandidate, error) {
+				checks, err := listPagespeedChecks(ctx, client)
+				if err != nil {
+					return nil, err
+				}
+
+				candidates := make([]importCandidate, len(checks))
+				for i, check := range checks {
+					candidates[i] = importCandidate{
+						id:     check.ID,
+						values: map[string][]string{"name": {check.Name}},
+					}
+				}
+				return candidates, nil
+			},
+		},
+		// SSL checks have neither names nor tags so are selected by address.
+		"statuscake_ssl_check": {
+			name: "SSL check",
+			keys: []string{"address"},
+			get: func(ctx context.Context, client *statuscake.Client, id string) error {
+				_, err := client.GetSslTest(ctx, id).Execute()
+				return err
+			},
+			list: func(ctx context.Context, client *statuscake.Client) ([]importCandidate, error) {
+				checks, err := listSSLChecks(ctx, client)
+				if err != nil {
+					return nil, err
+				}
+
+				candidates := make([]importCandidate, len(checks))
+				for i, check := range checks {
+					candidates[i] = importCandidate{
+						id:     check.ID,
+						values: map[string][]string{"address": {normalizeAddress(check.WebsiteURL)}},
+					}
+				}
+				return candidates, nil
+			},
+		},
+		"statuscake_uptime_check": {
+			name: "uptime check",
+			keys: []string{"name", "tag"},
+			get: func(ctx context.Context, client *statuscake.Client, id string) error {
+				res, err := client.GetUptimeTest(ctx, id).Execute()
+				if err != nil {
+					return err
+				}
+
+				if !isUptimeCheckType(res.Data.TestType) {
+					return fmt.Errorf("uptime check with ID: %s has unsupported test type %s", id, res.Data.TestType)
+				}
+				return nil
+			},
+			list: func(ctx context.Context, client *statuscake.Client) ([]importCandidate, error) {
+				checks, err := listUptimeChecks(ctx, client)
+				if err != nil {
+					return nil, err
+				}
+
+				var candidates []importCandidate
+				for _, check := range checks {
+					if !isUptimeCheckType(check.TestType) {
+						continue
+					}
+
+					candidates = append(candidates, importCandidate{
+						id:     check.ID,
+						values: map[string][]string{"name": {check.Name}, "tag": check.Tags},
+					})
+				}
+				return candidates, nil
+			},
+		},
+	}
+}
+
+// isUptimeCheckType reports whether the test type is supported by the uptime
+// check resource.
+func isUptimeCheckType(t statuscake.UptimeTestType) bool {
+	return t == statuscake.UptimeTestTypeDNS ||
+		t == statuscake.UptimeTestTypePING ||
+		isHTTPCheckType(t) ||
+		isTCPCheckType(t)
+}
+
+// importer returns a schema.ResourceImporter for the given resource type. The
+// import ID is either the ID of the object to import, or of the form
+// <key>=<value> to select the only object with the given name, tag, or
+// address. The object is verified to exist before it is imported.
+func importer(resource string) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			client := meta.(*providerConfig).client
+
+			id, err := resolveImportID(ctx, client, importKinds(), resource, d.Id())
+			if err != nil {
+				return nil, err
+			}
+
+			d.SetId(id)
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+// resolveImportID returns the ID of the object selected by the import ID. The
+// objects of each resource type are found as described by kinds.
+func resolveImportID(ctx context.Context, client *statuscake.Client, kinds map[string]importKind, resource, importID string) (string, error) {
+	kind := kinds[resource]
+
+	if key, value, ok := strings.Cut(importID, "="); ok {
+		if !slices.Contains(kind.keys, key) {
+			return "", fmt.Errorf("invalid import ID %q, expected an ID or %s", importID, importIDFormats(kind.keys))
+		}
+
+		candidates, err := kind.list(ctx, client)
+		if err != nil {
+			return "", err
+		}
+
+		if key == "address" {
+			value = normalizeAddress(value)
+		}
+
+		var ids []string
+		for _, candidate := range candidates {
+			if slices.Contains(candidate.values[key], value) {
+				ids = append(ids, candidate.id)
+			}
+		}
+
+		switch len(ids) {
+		case 0:
+			return "", fmt.Errorf("no %s found with %s %q", kind.name, key, value)
+		case 1:
+			return ids[0], nil
+		default:
+			return "", fmt.Errorf("found %d %ss with %s %q, import each by ID instead: %s", len(ids), kind.name, key, value, strings.Join(ids, ", "))
+		}
+	}
+
+	err := kind.get(ctx, client, importID)
+	if isNotFound(err) {
+		for _, other := range slices.Sorted(maps.Keys(kinds)) {
+			if other == resource {
+				continue
+			}
+
+			if kinds[other].get(ctx, client, importID) == nil {
+				return "", fmt.Errorf("%s with ID: %s does not exist, the ID belongs to a %s which must be imported into %s", kind.name, importID, kinds[other].name, other)
+			}
+		}
+		return "", fmt.Errorf("%s with ID: %s does not exist", kind.name, importID)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get %s with ID: %s, error: %w", kind.name, importID, err)
+	}
+
+	return importID, nil
+}
+
+// importIDFormats returns the import ID formats accepted for the given keys.
+func importIDFormats(keys []string) string {
+	formats := make([]string, len(keys))
+	for i, key := range keys {
+		formats[i] = fmt.Sprintf("%s=<%s>", key, key)
+	}
+	return strings.Join(formats, " or ")
+}
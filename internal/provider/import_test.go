@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/StatusCakeDev/statuscake-go"
+)
+
+// testImportKind returns an importKind for objects with the given IDs that
+// may be selected by the given candidates.
+func testImportKind(name string, keys, ids []string, candidates []importCandidate) importKind {
+	return importKind{
+		name: name,
+		keys: keys,
+		get: func(_ context.Context, _ *statuscake.Client, id string) error {
+			if id == "error" {
+				return errors.New("internal server error")
+			}
+			if !slices.Contains(ids, id) {
+				return statuscake.APIError{Status: http.StatusNotFound}
+			}
+			return nil
+		},
+		list: func(_ context.Context, _ *statuscake.Client) ([]importCandidate, error) {
+			return candidates, nil
+		},
+	}
+}
+
+func TestResolveImportID(t *testing.T) {
+	kinds := map[string]importKind{
+		"statuscake_uptime_check": testImportKind("uptime check", []string{"name", "tag"}, []string{"1", "2", "3"}, []importCandidate{
+			{id: "1", values: map[string][]string{"name": {"Website"}, "tag": {"production", "web"}}},
+			{id: "2", values: map[string][]string{"name": {"API"}, "tag": {"production"}}},
+			{id: "3", values: map[string][]string{"name": {"Blog"}}},
+		}),
+		"statuscake_ssl_check": testImportKind("SSL check", []string{"address"}, []string{"10"}, []importCandidate{
+			{id: "10", values: map[string][]string{"address": {"https://www.example.com/"}}},
+		}),
+	}
+
+	tests := []struct {
+		name     string
+		resource string
+		importID string
+		expected string
+		err      string
+	}{
+		{
+			name:     "returns an existing ID",
+			resource: "statuscake_uptime_check",
+			importID: "1",
+			expected: "1",
+		},
+		{
+			name:     "selects the only object with the name",
+			resource: "statuscake_uptime_check",
+			importID: "name=API",
+			expected: "2",
+		},
+		{
+			name:     "selects the only object with the tag",
+			resource: "statuscake_uptime_check",
+			importID: "tag=web",
+			expected: "1",
+		},
+		{
+			name:     "selects the only object with an equivalent address",
+			resource: "statuscake_ssl_check",
+			importID: "address=HTTPS://WWW.EXAMPLE.COM",
+			expected: "10",
+		},
+		{
+			name:     "returns an error when more than one object matches",
+			resource: "statuscake_uptime_check",
+			importID: "tag=production",
+			err:      `found 2 uptime checks with tag "production", import each by ID instead: 1, 2`,
+		},
+		{
+			name:     "returns an error when no object matches",
+			resource: "statuscake_uptime_check",
+			importID: "name=Missing",
+			err:      `no uptime check found with name "Missing"`,
+		},
+		{
+			name:     "returns an error when the key is not supported",
+			resource: "statuscake_uptime_check",
+			importID: "address=https://www.example.com",
+			err:      `invalid import ID "address=https://www.example.com", expected an ID or name=<name> or tag=<tag>`,
+		},
+		{
+			name:     "returns an error naming the resource type an ID belongs to",
+			resource: "statuscake_uptime_check",
+			importID: "10",
+			err:      "uptime check with ID: 10 does not exist, the ID belongs to a SSL check which must be imported into statuscake_ssl_check",
+		},
+		{
+			name:     "returns an error when the ID does not exist",
+			resource: "statuscake_uptime_check",
+			importID: "99",
+			err:      "uptime check with ID: 99 does not exist",
+		},
+		{
+			name:     "returns an error when the object cannot be fetched",
+			resource: "statuscake_uptime_check",
+			importID: "error",
+			err:      "failed to get uptime check with ID: error, error: internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := resolveImportID(context.Background(), nil, kinds, tt.resource, tt.importID)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("expected error %q but got: %v", tt.err, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+
+			if id != tt.expected {
+				t.Errorf("expected %q but got %q", tt.expected, id)
+			}
+		})
+	}
+}
+
+func TestImportIDFormats(t *testing.T) {
+	tests := []struct {
+		keys     []string
+		expected string
+	}{
+		{keys: []string{"name"}, expected: "name=<name>"},
+		{keys: []string{"name", "tag"}, expected: "name=<name> or tag=<tag>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if actual := importIDFormats(tt.keys); actual != tt.expected {
+				t.Errorf("expected %q but got %q", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/StatusCakeDev/statuscake-go"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// monitoringLocationsByRegion lists all monitoring locations returned by fn
+// and groups them by their region code.
+func monitoringLocationsByRegion(ctx context.Context, client *statuscake.Client, fn monitoringLocationsFunc) (map[string][]statuscake.MonitoringLocation, error) {
+	res, err := fn(ctx, client, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list monitoring locations: %w", err)
+	}
+
+	return groupMonitoringLocationsByRegion(res.Data), nil
+}
+
+// groupMonitoringLocationsByRegion groups the given monitoring locations by
+// their region code.
+func groupMonitoringLocationsByRegion(locations []statuscake.MonitoringLocation) map[string][]statuscake.MonitoringLocation {
+	regions := make(map[string][]statuscake.MonitoringLocation)
+	for _, location := range locations {
+		regions[location.RegionCode] = append(regions[location.RegionCode], location)
+	}
+
+	return regions
+}
+
+// validateRegionCode returns an error if the region code does not identify any
+// of the given monitoring locations. The error suggests the closest matching
+// region codes.
+func validateRegionCode(regions map[string][]statuscake.MonitoringLocation, key, code string) error {
+	if _, ok := regions[code]; ok {
+		return nil
+	}
+
+	codes := make([]string, 0, len(regions))
+	for c := range regions {
+		codes = append(codes, c)
+	}
+
+	return fmt.Errorf("%s: unknown region code %q, did you mean one of: %s", key, code, strings.Join(closest(code, codes, 3), ", "))
+}
+
+// regionCodeDiagnostics returns a warning if every monitoring location within
+// the region reports a non-up status since checks run from that region are
+// unlikely to succeed. Nothing is returned for regions without any of the
+// given monitoring locations.
+func regionCodeDiagnostics(regions map[string][]statuscake.MonitoringLocation, path cty.Path, code string) diag.Diagnostics {
+	locations := regions[code]
+	if len(locations) == 0 {
+		return nil
+	}
+
+	for _, location := range locations {
+		if location.Status == statuscake.MonitoringLocationStatusUp {
+			return nil
+		}
+	}
+
+	return diag.Diagnostics{{
+		Severity:      diag.Warning,
+		Summary:       "region has no available monitoring locations",
+		Detail:        fmt.Sprintf("Every monitoring location in region %q reports a non-up status. Checks run from this region are unlikely to succeed until a location recovers.", code),
+		AttributePath: path,
+	}}
+}
@@ -0,0 +1,349 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The plugin SDK does not support moving state between resource types. Moves
+// from the resources of the legacy v1 provider are therefore handled by
+// providerServer directly. Since the legacy resources stored each attribute at
+// the top level, their state is converted into the nested blocks of the
+// current resources.
+
+// legacyMove describes how the state of a legacy resource is moved.
+type legacyMove struct {
+	// target is the resource type into which the state may be moved.
+	target string
+	// move returns the ID and attributes of the target resource converted from
+	// the legacy state.
+	move func(src map[string]interface{}) (string, map[string]interface{}, error)
+}
+
+// legacyMoves returns how the state of each legacy resource is moved, keyed by
+// the legacy resource type.
+func legacyMoves() map[string]legacyMove {
+	return map[string]legacyMove{
+		"statuscake_ssl": {
+			target: "statuscake_ssl_check",
+			move:   moveLegacySSL,
+		},
+		"statuscake_test": {
+			target: "statuscake_uptime_check",
+			move:   moveLegacyTest,
+		},
+	}
+}
+
+func (s *providerServer) MoveResourceState(ctx context.Context, req *tfprotov5.MoveResourceStateRequest) (*tfprotov5.MoveResourceStateResponse, error) {
+	m, ok := legacyMoves()[req.SourceTypeName]
+	if !ok || m.target != req.TargetTypeName || !isStatusCakeProviderAddress(req.SourceProviderAddress) {
+		return s.GRPCProviderServer.MoveResourceState(ctx, req)
+	}
+
+	state, err := moveLegacyState(s.provider.ResourcesMap[m.target], req.SourceState, m.move)
+	if err != nil {
+		return &tfprotov5.MoveResourceStateResponse{
+			Diagnostics: errorDiagnostics(fmt.Sprintf("Failed to move %s to %s", req.SourceTypeName, req.TargetTypeName), err),
+		}, nil
+	}
+
+	return &tfprotov5.MoveResourceStateResponse{TargetState: state}, nil
+}
+
+// isStatusCakeProviderAddress reports whether the provider address is that of
+// this provider, such as registry.terraform.io/statuscakedev/statuscake. The
+// hostname is ignored so that mirrored providers may also be moved from.
+func isStatusCakeProviderAddress(address string) bool {
+	parts := strings.Split(strings.ToLower(address), "/")
+	return len(parts) >= 2 && parts[len(parts)-2] == "statuscakedev" && parts[len(parts)-1] == "statuscake"
+}
+
+// moveLegacyState returns the state of the target resource converted from the
+// raw state of a legacy resource.
+func moveLegacyState(r *schema.Resource, raw *tfprotov5.RawState, move func(map[string]interface{}) (string, map[string]interface{}, error)) (*tfprotov5.DynamicValue, error) {
+	if raw == nil || len(raw.JSON) == 0 {
+		return nil, errors.New("the source state is empty")
+	}
+
+	var src map[string]interface{}
+	if err := json.Unmarshal(raw.JSON, &src); err != nil {
+		return nil, fmt.Errorf("failed to decode the source state: %w", err)
+	}
+
+	id, attrs, err := move(src)
+	if err != nil {
+		return nil, err
+	}
+
+	if id == "" {
+		return nil, errors.New("the source state has no ID")
+	}
+
+	// Attributes not stored by the legacy resource, including those within
+	// nested blocks, take their default values.
+	d := r.Data(nil)
+	d.SetId(id)
+	if err := setLegacyAttributes(d, withSchemaDefaults(r.SchemaMap(), attrs)); err != nil {
+		return nil, err
+	}
+
+	// Write-only attributes must not be stored within the state.
+	state := d.State()
+	writeOnly := writeOnlyAttributes(r.SchemaMap())
+	for key := range state.Attributes {
+		if writeOnly[key[strings.LastIndex(key, ".")+1:]] {
+			delete(state.Attributes, key)
+		}
+	}
+
+	ty := r.CoreConfigSchema().ImpliedType()
+
+	v, err := state.AttrsAsObjectValue(ty)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := ctyjson.Marshal(v, ty)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tfprotov5.DynamicValue{JSON: b}, nil
+}
+
+// writeOnlyAttributes returns the names of the write-only attributes within the
+// schema, including those of nested blocks.
+func writeOnlyAttributes(s map[string]*schema.Schema) map[string]bool {
+	names := make(map[string]bool)
+	for key, sch := range s {
+		if sch.WriteOnly {
+			names[key] = true
+		}
+
+		if elem, ok := sch.Elem.(*schema.Resource); ok {
+			for name := range writeOnlyAttributes(elem.SchemaMap()) {
+				names[name] = true
+			}
+		}
+	}
+	return names
+}
+
+// moveLegacyTest returns the ID and attributes of an uptime check converted
+// from the state of a legacy statuscake_test resource.
+func moveLegacyTest(src map[string]interface{}) (string, map[string]interface{}, error) {
+	id := legacyString(src, "test_id")
+	if id == "" {
+		id = legacyString(src, "id")
+	}
+
+	attrs := map[string]interface{}{
+		"check_interval": legacyString(src, "check_rate"),
+		"contact_groups": legacyStrings(src, "contact_group"),
+		"name":           legacyString(src, "website_name"),
+		"paused":         legacyBool(src, "paused"),
+		"tags":           legacyStrings(src, "test_tags"),
+		"monitored_resource": []interface{}{
+			map[string]interface{}{
+				"address": legacyString(src, "website_url"),
+				"host":    legacyString(src, "website_host"),
+			},
+		},
+	}
+
+	if v := legacyString(src, "confirmations"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid confirmations: %w", err)
+		}
+		attrs["confirmation"] = n
+	}
+
+	if v := legacyString(src, "trigger_rate"); v != "" {
+		attrs["trigger_rate"] = v
+	}
+
+	switch testType := strings.ToUpper(legacyString(src, "test_type")); testType {
+	case "HEAD", "HTTP":
+		check := map[string]interface{}{
+			"final_endpoint":   legacyString(src, "final_endpoint"),
+			"follow_redirects": legacyBool(src, "follow_redirect"),
+			"request_method":   testType,
+			"status_codes":     legacyStatusCodes(legacyString(src, "status_codes")),
+			"user_agent":       legacyString(src, "user_agent"),
+			"validate_ssl":     legacyBool(src, "enable_ssl_alert"),
+		}
+
+		if timeout := legacyString(src, "timeout"); timeout != "" {
+			check["timeout"] = timeout
+		}
+
+		if username := legacyString(src, "basic_user"); username != "" {
+			check["basic_authentication"] = []interface{}{
+				map[string]interface{}{
+					"username": username,
+					"password": legacyString(src, "basic_pass"),
+				},
+			}
+		}
+
+		if content := legacyString(src, "find_string"); content != "" {
+			matcher := matcherContains
+			if legacyBool(src, "do_not_find") {
+				matcher = matcherNoContains
+			}
+
+			check["content_matchers"] = []interface{}{
+				map[string]interface{}{
+					"content": content,
+					"matcher": matcher,
+				},
+			}
+		}
+
+		attrs["http_check"] = []interface{}{check}
+	case "SMTP", "SSH", "TCP":
+		port, err := strconv.Atoi(legacyString(src, "port"))
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid port: %w", err)
+		}
+
+		check := map[string]interface{}{
+			"port":     port,
+			"protocol": testType,
+		}
+
+		if timeout := legacyString(src, "timeout"); timeout != "" {
+			check["timeout"] = timeout
+		}
+
+		attrs["tcp_check"] = []interface{}{check}
+	case "PING":
+		attrs["icmp_check"] = []interface{}{
+			map[string]interface{}{},
+		}
+	case "DNS":
+		check := map[string]interface{}{
+			"dns_server": legacyString(src, "dns_server"),
+		}
+
+		if ip := legacyString(src, "dns_ip"); ip != "" {
+			check["dns_ips"] = []interface{}{ip}
+		}
+
+		attrs["dns_check"] = []interface{}{check}
+	default:
+		return "", nil, fmt.Errorf("unsupported test type %q", testType)
+	}
+
+	return id, attrs, nil
+}
+
+// moveLegacySSL returns the ID and attributes of an SSL check converted from
+// the state of a legacy statuscake_ssl resource.
+func moveLegacySSL(src map[string]interface{}) (string, map[string]interface{}, error) {
+	var alertAt []interface{}
+	for _, v := range strings.Split(legacyString(src, "alert_at"), ",") {
+		if v = strings.TrimSpace(v); v == "" {
+			continue
+		}
+
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid alert_at: %w", err)
+		}
+		alertAt = append(alertAt, n)
+	}
+
+	return legacyString(src, "id"), map[string]interface{}{
+		"check_interval": legacyString(src, "checkrate"),
+		"contact_groups": legacyStrings(src, "contact_groups"),
+		"paused":         legacyBool(src, "paused"),
+		"alert_config": []interface{}{
+			map[string]interface{}{
+				"alert_at":    alertAt,
+				"on_broken":   legacyBool(src, "alert_broken"),
+				"on_expiry":   legacyBool(src, "alert_expiry"),
+				"on_mixed":    legacyBool(src, "alert_mixed"),
+				"on_reminder": legacyBool(src, "alert_reminder"),
+			},
+		},
+		"monitored_resource": []interface{}{
+			map[string]interface{}{
+				"address": legacyString(src, "domain"),
+			},
+		},
+	}, nil
+}
+
+// setLegacyAttributes sets each attribute converted from a legacy state.
+func setLegacyAttributes(d *schema.ResourceData, attrs map[string]interface{}) error {
+	for key, v := range attrs {
+		if err := d.Set(key, v); err != nil {
+			return fmt.Errorf("failed to set %s: %w", key, err)
+		}
+	}
+	return nil
+}
+
+// legacyString returns the attribute of a legacy state as a string. Legacy
+// numbers are returned in their decimal form.
+func legacyString(src map[string]interface{}, key string) string {
+	switch v := src[key].(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return ""
+	}
+}
+
+// legacyBool returns the attribute of a legacy state as a bool.
+func legacyBool(src map[string]interface{}, key string) bool {
+	switch v := src[key].(type) {
+	case bool:
+		return v
+	case string:
+		b, _ := strconv.ParseBool(v)
+		return b
+	default:
+		return false
+	}
+}
+
+// legacyStrings returns the attribute of a legacy state as a list of strings.
+func legacyStrings(src map[string]interface{}, key string) []interface{} {
+	list, _ := src[key].([]interface{})
+
+	s := make([]interface{}, 0, len(list))
+	for _, v := range list {
+		if str, ok := v.(string); ok && str != "" {
+			s = append(s, str)
+		}
+	}
+	return s
+}
+
+// legacyStatusCodes returns the list of status codes within the comma
+// separated string stored by legacy resources.
+func legacyStatusCodes(v string) []interface{} {
+	var codes []interface{}
+	for _, code := range strings.Split(v, ",") {
+		if code = normalizeStatusCode(code); code != "" {
+			codes = append(codes, code)
+		}
+	}
+	return codes
+}
@@ -0,0 +1,379 @@
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+// legacyMoveTest describes the ID and attributes expected to be returned when
+// moving the given legacy state.
+type legacyMoveTest struct {
+	name     string
+	state    string
+	id       string
+	expected map[string]interface{}
+	err      string
+}
+
+// testLegacyMove runs each test against the legacy move.
+func testLegacyMove(t *testing.T, move func(map[string]interface{}) (string, map[string]interface{}, error), tests []legacyMoveTest) {
+	t.Helper()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var src map[string]interface{}
+			if err := json.Unmarshal([]byte(tt.state), &src); err != nil {
+				t.Fatalf("failed to decode state: %+v", err)
+			}
+
+			id, attrs, err := move(src)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("expected error %q but got: %v", tt.err, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+
+			if id != tt.id {
+				t.Errorf("expected ID %q but got %q", tt.id, id)
+			}
+
+			if !reflect.DeepEqual(attrs, tt.expected) {
+				t.Errorf("unexpected attributes\nexpected: %#v\nactual:   %#v", tt.expected, attrs)
+			}
+		})
+	}
+}
+
+func TestMoveLegacyTest(t *testing.T) {
+	testLegacyMove(t, moveLegacyTest, []legacyMoveTest{
+		{
+			name: "converts an HTTP test",
+			state: `{
+				"id": "1",
+				"test_id": "1",
+				"website_name": "example",
+				"website_url": "https://www.example.com",
+				"test_type": "HTTP",
+				"check_rate": 300,
+				"confirmations": 2,
+				"trigger_rate": 5,
+				"contact_group": ["10", ""],
+				"test_tags": ["production"],
+				"paused": false,
+				"status_codes": "404, 5xx,",
+				"find_string": "error",
+				"do_not_find": true,
+				"basic_user": "picard",
+				"basic_pass": "engage",
+				"follow_redirect": true,
+				"enable_ssl_alert": true,
+				"timeout": 40
+			}`,
+			id: "1",
+			expected: map[string]interface{}{
+				"check_interval": "300",
+				"confirmation":   2,
+				"contact_groups": []interface{}{"10"},
+				"name":           "example",
+				"paused":         false,
+				"tags":           []interface{}{"production"},
+				"trigger_rate":   "5",
+				"monitored_resource": []interface{}{
+					map[string]interface{}{
+						"address": "https://www.example.com",
+						"host":    "",
+					},
+				},
+				"http_check": []interface{}{
+					map[string]interface{}{
+						"final_endpoint":   "",
+						"follow_redirects": true,
+						"request_method":   "HTTP",
+						"status_codes":     []interface{}{"404", "5xx"},
+						"timeout":          "40",
+						"user_agent":       "",
+						"validate_ssl":     true,
+						"basic_authentication": []interface{}{
+							map[string]interface{}{
+								"username": "picard",
+								"password": "engage",
+							},
+						},
+						"content_matchers": []interface{}{
+							map[string]interface{}{
+								"content": "error",
+								"matcher": matcherNoContains,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "converts a TCP test",
+			state: `{
+				"id": "2",
+				"website_name": "example",
+				"website_url": "203.0.113.1",
+				"test_type": "SSH",
+				"check_rate": "60",
+				"port": 22,
+				"timeout": 15
+			}`,
+			id: "2",
+			expected: map[string]interface{}{
+				"check_interval": "60",
+				"contact_groups": []interface{}{},
+				"name":           "example",
+				"paused":         false,
+				"tags":           []interface{}{},
+				"monitored_resource": []interface{}{
+					map[string]interface{}{
+						"address": "203.0.113.1",
+						"host":    "",
+					},
+				},
+				"tcp_check": []interface{}{
+					map[string]interface{}{
+						"port":     22,
+						"protocol": "SSH",
+						"timeout":  "15",
+					},
+				},
+			},
+		},
+		{
+			name: "converts a PING test",
+			state: `{
+				"id": "3",
+				"website_name": "example",
+				"website_url": "203.0.113.1",
+				"test_type": "ping",
+				"check_rate": 300,
+				"paused": "true"
+			}`,
+			id: "3",
+			expected: map[string]interface{}{
+				"check_interval": "300",
+				"contact_groups": []interface{}{},
+				"name":           "example",
+				"paused":         true,
+				"tags":           []interface{}{},
+				"monitored_resource": []interface{}{
+					map[string]interface{}{
+						"address": "203.0.113.1",
+						"host":    "",
+					},
+				},
+				"icmp_check": []interface{}{
+					map[string]interface{}{},
+				},
+			},
+		},
+		{
+			name: "converts a DNS test",
+			state: `{
+				"id": "4",
+				"website_name": "example",
+				"website_url": "www.example.com",
+				"test_type": "DNS",
+				"check_rate": 300,
+				"dns_server": "8.8.8.8",
+				"dns_ip": "203.0.113.1"
+			}`,
+			id: "4",
+			expected: map[string]interface{}{
+				"check_interval": "300",
+				"contact_groups": []interface{}{},
+				"name":           "example",
+				"paused":         false,
+				"tags":           []interface{}{},
+				"monitored_resource": []interface{}{
+					map[string]interface{}{
+						"address": "www.example.com",
+						"host":    "",
+					},
+				},
+				"dns_check": []interface{}{
+					map[string]interface{}{
+						"dns_server": "8.8.8.8",
+						"dns_ips":    []interface{}{"203.0.113.1"},
+					},
+				},
+			},
+		},
+		{
+			name:  "returns an error when the test type is not supported",
+			state: `{"id": "5", "test_type": "PUSH"}`,
+			err:   `unsupported test type "PUSH"`,
+		},
+		{
+			name:  "returns an error when the port is not a number",
+			state: `{"id": "6", "test_type": "TCP", "port": ""}`,
+			err:   `invalid port: strconv.Atoi: parsing "": invalid syntax`,
+		},
+	})
+}
+
+func TestMoveLegacySSL(t *testing.T) {
+	testLegacyMove(t, moveLegacySSL, []legacyMoveTest{
+		{
+			name: "converts an SSL check",
+			state: `{
+				"id": "1",
+				"domain": "https://www.example.com",
+				"checkrate": 86400,
+				"contact_groups": ["10"],
+				"alert_at": "7, 14,,30",
+				"alert_broken": true,
+				"alert_expiry": true,
+				"alert_mixed": false,
+				"alert_reminder": true,
+				"paused": false
+			}`,
+			id: "1",
+			expected: map[string]interface{}{
+				"check_interval": "86400",
+				"contact_groups": []interface{}{"10"},
+				"paused":         false,
+				"alert_config": []interface{}{
+					map[string]interface{}{
+						"alert_at":    []interface{}{7, 14, 30},
+						"on_broken":   true,
+						"on_expiry":   true,
+						"on_mixed":    false,
+						"on_reminder": true,
+					},
+				},
+				"monitored_resource": []interface{}{
+					map[string]interface{}{
+						"address": "https://www.example.com",
+					},
+				},
+			},
+		},
+		{
+			name:  "returns an error when alert_at is not a list of numbers",
+			state: `{"id": "2", "alert_at": "7,fourteen"}`,
+			err:   `invalid alert_at: strconv.Atoi: parsing "fourteen": invalid syntax`,
+		},
+	})
+}
+
+func TestLegacyString(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected string
+	}{
+		{name: "returns strings unchanged", value: "300", expected: "300"},
+		{name: "formats whole numbers without a fraction", value: float64(86400), expected: "86400"},
+		{name: "formats fractional numbers", value: 1.5, expected: "1.5"},
+		{name: "formats booleans", value: true, expected: "true"},
+		{name: "returns an empty string for missing values", value: nil, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := legacyString(map[string]interface{}{"key": tt.value}, "key"); actual != tt.expected {
+				t.Errorf("expected %q but got %q", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestMoveLegacyState(t *testing.T) {
+	r := resourceStatusCakeUptimeCheck()
+
+	move := func(t *testing.T, state string) (map[string]interface{}, error) {
+		t.Helper()
+
+		v, err := moveLegacyState(r, &tfprotov5.RawState{JSON: []byte(state)}, moveLegacyTest)
+		if err != nil {
+			return nil, err
+		}
+
+		var attrs map[string]interface{}
+		if err := json.Unmarshal(v.JSON, &attrs); err != nil {
+			t.Fatalf("failed to decode moved state: %+v", err)
+		}
+		return attrs, nil
+	}
+
+	t.Run("applies the defaults of nested blocks", func(t *testing.T) {
+		attrs, err := move(t, `{"id": "1", "website_url": "https://www.example.com", "test_type": "HTTP", "check_rate": 300}`)
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+
+		check := attrs["http_check"].([]interface{})[0].(map[string]interface{})
+		if check["timeout"] != "15" {
+			t.Errorf("expected the default timeout but got %v", check["timeout"])
+		}
+
+		if attrs["on_destroy"] != onDestroyDelete {
+			t.Errorf("expected the default on_destroy but got %v", attrs["on_destroy"])
+		}
+	})
+
+	t.Run("does not store write-only attributes", func(t *testing.T) {
+		attrs, err := move(t, `{"id": "1", "website_url": "https://www.example.com", "test_type": "HTTP", "check_rate": 300, "basic_user": "picard", "basic_pass": "engage"}`)
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+
+		check := attrs["http_check"].([]interface{})[0].(map[string]interface{})
+		auth := check["basic_authentication"].([]interface{})[0].(map[string]interface{})
+		if auth["password_wo"] != nil {
+			t.Errorf("expected no write-only password but got %v", auth["password_wo"])
+		}
+
+		if auth["password"] != "engage" {
+			t.Errorf("expected the password to be moved but got %v", auth["password"])
+		}
+	})
+
+	t.Run("returns an error when the state has no ID", func(t *testing.T) {
+		_, err := move(t, `{"website_url": "203.0.113.1", "test_type": "PING"}`)
+		if err == nil || err.Error() != "the source state has no ID" {
+			t.Errorf("expected an error but got: %v", err)
+		}
+	})
+
+	t.Run("returns an error when the state is empty", func(t *testing.T) {
+		_, err := moveLegacyState(r, &tfprotov5.RawState{}, moveLegacyTest)
+		if err == nil || err.Error() != "the source state is empty" {
+			t.Errorf("expected an error but got: %v", err)
+		}
+	})
+}
+
+func TestIsStatusCakeProviderAddress(t *testing.T) {
+	tests := []struct {
+		address  string
+		expected bool
+	}{
+		{address: "registry.terraform.io/statuscakedev/statuscake", expected: true},
+		{address: "registry.terraform.io/StatusCakeDev/statuscake", expected: true},
+		{address: "mirror.example.com/statuscakedev/statuscake", expected: true},
+		{address: "registry.terraform.io/example/statuscake", expected: false},
+		{address: "registry.terraform.io/statuscakedev/other", expected: false},
+		{address: "statuscake", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			if actual := isStatusCakeProviderAddress(tt.address); actual != tt.expected {
+				t.Errorf("expected %t but got %t", tt.expected, actual)
+			}
+		})
+	}
+}
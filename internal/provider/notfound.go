@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/StatusCakeDev/statuscake-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// readAfterCreateTimeout is the length of time for which a newly created
+	// object may not be found before it is considered missing.
+	readAfterCreateTimeout = time.Minute
+
+	// readAfterCreateInterval is the length of time between attempts to read a
+	// newly created object.
+	readAfterCreateInterval = 2 * time.Second
+)
+
+// isNotFound reports whether the error was returned by the StatusCake API
+// because the requested object does not exist.
+func isNotFound(err error) bool {
+	var apiErr statuscake.APIError
+	return errors.As(err, &apiErr) && apiErr.Status == http.StatusNotFound
+}
+
+// retryNotFound calls get and returns its result. The StatusCake API is
+// eventually consistent so an object may not be found immediately after it has
+// been created. Therefore when reading a new resource get is retried until the
+// object is found or readAfterCreateTimeout elapses.
+func retryNotFound[T any](ctx context.Context, d *schema.ResourceData, get func() (T, error)) (T, error) {
+	res, err := get()
+	if !d.IsNewResource() {
+		return res, err
+	}
+
+	deadline := time.Now().Add(readAfterCreateTimeout)
+	for isNotFound(err) && time.Now().Before(deadline) {
+		log.Printf("[DEBUG] StatusCake object with ID: %s not found after creation, retrying", d.Id())
+
+		select {
+		case <-ctx.Done():
+			return res, ctx.Err()
+		case <-time.After(readAfterCreateInterval):
+		}
+
+		res, err = get()
+	}
+
+	return res, err
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// managePausedSchema returns the schema describing whether Terraform manages
+// the paused state of a check. Since every check type supports pausing its
+// structure has been encapsulated within a function.
+func managePausedSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Computed:    true,
+		Description: "Whether Terraform manages the paused state of the check. When disabled the `paused` attribute is ignored and the check is never paused or resumed by Terraform, allowing it to be paused outside of Terraform such as within the StatusCake UI. Defaults to the `manage_paused` provider setting",
+	}
+}
+
+// managePausedDiff plans the provider default for manage_paused when it is not
+// specified by the check.
+func managePausedDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if config := d.GetRawConfig(); !config.IsNull() && !config.GetAttr("manage_paused").IsNull() {
+		return nil
+	}
+
+	managed := meta.(*providerConfig).managePaused
+	if d.Get("manage_paused").(bool) == managed && d.Id() != "" {
+		return nil
+	}
+
+	return d.SetNew("manage_paused", managed)
+}
+
+// managePaused reports whether Terraform manages the paused state of a check
+// being read. The provider default applies when the state does not record
+// whether the paused state is managed, such as when the check is imported.
+func managePaused(d *schema.ResourceData, meta interface{}) bool {
+	state := d.GetRawState()
+	if state.IsNull() {
+		// The check has just been created and so the planned value is known.
+		return d.Get("manage_paused").(bool)
+	}
+
+	if v := state.GetAttr("manage_paused"); v.IsKnown() && !v.IsNull() {
+		return v.True()
+	}
+
+	return meta.(*providerConfig).managePaused
+}
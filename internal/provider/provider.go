@@ -19,6 +19,25 @@ import (
 	"github.com/StatusCakeDev/statuscake-go/throttle"
 )
 
+// providerConfig is the Terraform provider meta object passed to each resource
+// and data source.
+type providerConfig struct {
+	client *statuscake.Client
+
+	// contactGroups caches the existence of contact groups for the lifetime of
+	// the provider process. It is nil when contact group validation is
+	// disabled.
+	contactGroups *contactGroupCache
+
+	// managePaused is the default for whether Terraform manages the paused
+	// state of checks.
+	managePaused bool
+
+	// uptimeChecks caches the listing of uptime checks for the lifetime of the
+	// provider process.
+	uptimeChecks *uptimeCheckCache
+}
+
 // Provider returns a resource provider for Terraform.
 func Provider() *schema.Provider {
 	return &schema.Provider{
@@ -51,6 +70,12 @@ func Provider() *schema.Provider {
 				Description:  "Minimum backoff period in seconds after failed API calls. This can also be provided as an environment variable `STATUSCAKE_MIN_BACKOFF`",
 				ValidateFunc: validation.IntAtLeast(0),
 			},
+			"manage_paused": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("STATUSCAKE_MANAGE_PAUSED", true),
+				Description: "Whether Terraform manages the paused state of checks that do not specify `manage_paused`. This can also be provided as an environment variable `STATUSCAKE_MANAGE_PAUSED`",
+			},
 			"max_backoff": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -65,6 +90,12 @@ func Provider() *schema.Provider {
 				Description:  "Custom endpoint to which request will be made. This can also be provided as an environment variable `STATUCAKE_CUSTOM_ENDPOINT`",
 				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
 			},
+			"validate_contact_groups": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("STATUSCAKE_VALIDATE_CONTACT_GROUPS", false),
+				Description: "Whether to verify that contact groups referenced by checks exist during plan. This can also be provided as an environment variable `STATUSCAKE_VALIDATE_CONTACT_GROUPS`",
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"statuscake_contact_group":      resourceStatusCakeContactGroup(),
@@ -76,7 +107,9 @@ func Provider() *schema.Provider {
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"statuscake_contact_group":                  dataSourceStatusCakeContactGroup(),
+			"statuscake_maintenance_windows":            dataSourceStatusCakeMaintenanceWindows(),
 			"statuscake_pagespeed_monitoring_locations": dataSourceStatusCakeMonitoringLocations(listPagespeedMonitoringLocations),
+			"statuscake_unmanaged_resources":            dataSourceStatusCakeUnmanagedResources(),
 			"statuscake_uptime_monitoring_locations":    dataSourceStatusCakeMonitoringLocations(listUptimeMonitoringLocations),
 		},
 		ConfigureContextFunc: providerConfigure,
@@ -112,5 +145,15 @@ func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{},
 		opts = append(opts, statuscake.WithHost(customEndpoint.(string)))
 	}
 
-	return statuscake.NewClient(opts...), nil
+	config := &providerConfig{
+		client:       statuscake.NewClient(opts...),
+		managePaused: d.Get("manage_paused").(bool),
+		uptimeChecks: newUptimeCheckCache(),
+	}
+
+	if d.Get("validate_contact_groups").(bool) {
+		config.contactGroups = newContactGroupCache()
+	}
+
+	return config, nil
 }
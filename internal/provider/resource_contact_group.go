@@ -4,9 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"net/http"
 
-	"github.com/StatusCakeDev/statuscake-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -16,18 +14,17 @@ import (
 )
 
 func resourceStatusCakeContactGroup() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		CreateContext: resourceStatusCakeContactGroupCreate,
 		ReadContext:   resourceStatusCakeContactGroupRead,
 		UpdateContext: resourceStatusCakeContactGroupUpdate,
 		DeleteContext: resourceStatusCakeContactGroupDelete,
 
 		// Used by `terraform import`.
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer: importer("statuscake_contact_group"),
 
 		Schema: map[string]*schema.Schema{
+			"adopt_existing": adoptExistingSchema("name"),
 			"email_addresses": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -69,67 +66,78 @@ func resourceStatusCakeContactGroup() *schema.Resource {
 			},
 		},
 	}
+
+	// Used to upgrade the state stored by prior versions of the schema.
+	return withStateUpgrades(r)
 }
 
 func resourceStatusCakeContactGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*statuscake.Client)
+	client := meta.(*providerConfig).client
 	body := make(map[string]interface{})
 
 	emailAddresses, err := expandContactGroupEmailAddresses(d.Get("email_addresses"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("email_addresses") {
+	} else if hasChange(d, "email_addresses") {
 		body["email_addresses"] = emailAddresses
 	}
 
 	integrations, err := expandContactGroupIntegrations(d.Get("integrations"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("integrations") {
+	} else if hasChange(d, "integrations") {
 		body["integrations"] = integrations
 	}
 
 	mobileNumbers, err := expandContactGroupMobileNumbers(d.Get("mobile_numbers"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("mobile_numbers") {
+	} else if hasChange(d, "mobile_numbers") {
 		body["mobile_numbers"] = mobileNumbers
 	}
 
 	name, err := expandContactGroupName(d.Get("name"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("name") {
+	} else if hasChange(d, "name") {
 		body["name"] = name
 	}
 
 	url, err := expandContactGroupPingURL(d.Get("ping_url"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("ping_url") {
+	} else if hasChange(d, "ping_url") {
 		body["ping_url"] = url
 	}
 
-	log.Print("[DEBUG] Creating StatusCake contact group")
-	log.Printf("[DEBUG] Request body: %+v", body)
+	find := func() (string, error) {
+		return findContactGroup(ctx, client, d.Get("name").(string))
+	}
 
-	res, err := client.CreateContactGroupWithData(ctx, body).Execute()
-	if err != nil {
-		return intdiag.FromErr("failed to create contact group", err)
+	create := func() (string, error) {
+		res, err := client.CreateContactGroupWithData(ctx, body).Execute()
+		return res.Data.NewID, err
+	}
+
+	update := func(id string) error {
+		return client.UpdateContactGroupWithData(ctx, id, body).Execute()
 	}
 
-	d.SetId(res.Data.NewID)
+	if diags := createOrAdopt(d, "contact group", body, find, create, update); diags.HasError() {
+		return diags
+	}
 	return resourceStatusCakeContactGroupRead(ctx, d, meta)
 }
 
 func resourceStatusCakeContactGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*statuscake.Client)
+	client := meta.(*providerConfig).client
 	id := d.Id()
 
-	res, err := client.GetContactGroup(ctx, id).Execute()
+	res, err := retryNotFound(ctx, d, client.GetContactGroup(ctx, id).Execute)
 
-	// If the resource is not found then remove it from the state.
-	if err, ok := err.(statuscake.APIError); ok && err.Status == http.StatusNotFound {
+	// If the resource is not found then remove it from the state. A new resource
+	// that cannot be found is an error.
+	if isNotFound(err) && !d.IsNewResource() {
 		d.SetId("")
 		return nil
 	}
@@ -161,7 +169,7 @@ func resourceStatusCakeContactGroupRead(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceStatusCakeContactGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*statuscake.Client)
+	client := meta.(*providerConfig).client
 	body := make(map[string]interface{})
 	id := d.Id()
 
@@ -200,6 +208,12 @@ func resourceStatusCakeContactGroupUpdate(ctx context.Context, d *schema.Resourc
 		body["ping_url"] = url
 	}
 
+	// Attributes such as adopt_existing only change the behaviour of the
+	// provider, so changing them alone does not update the contact group.
+	if len(body) == 0 {
+		return resourceStatusCakeContactGroupRead(ctx, d, meta)
+	}
+
 	log.Printf("[DEBUG] Updating StatusCake contact group with ID: %s", id)
 	log.Printf("[DEBUG] Request body: %+v", body)
 
@@ -211,12 +225,12 @@ func resourceStatusCakeContactGroupUpdate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceStatusCakeContactGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*statuscake.Client)
+	client := meta.(*providerConfig).client
 	id := d.Id()
 
 	log.Printf("[DEBUG] Deleting StatusCake contact group with ID: %s", id)
 
-	if err := client.DeleteContactGroup(ctx, id).Execute(); err != nil {
+	if err := client.DeleteContactGroup(ctx, id).Execute(); err != nil && !isNotFound(err) {
 		return intdiag.FromErr(fmt.Sprintf("failed to delete contact group with id %s", id), err)
 	}
 
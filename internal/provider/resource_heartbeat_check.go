@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/StatusCakeDev/statuscake-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -16,18 +18,24 @@ import (
 )
 
 func resourceStatusCakeHeartbeatCheck() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		CreateContext: resourceStatusCakeHeartbeatCheckCreate,
 		ReadContext:   resourceStatusCakeHeartbeatCheckRead,
 		UpdateContext: resourceStatusCakeHeartbeatCheckUpdate,
 		DeleteContext: resourceStatusCakeHeartbeatCheckDelete,
 
 		// Used by `terraform import`.
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer: importer("statuscake_heartbeat_check"),
+
+		// Used to ensure referenced contact groups exist and to plan the
+		// provider default for manage_paused.
+		CustomizeDiff: customdiff.All(
+			contactGroupsDiff,
+			managePausedDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
+			"adopt_existing": adoptExistingSchema("name"),
 			"check_url": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -42,6 +50,7 @@ func resourceStatusCakeHeartbeatCheck() *schema.Resource {
 					ValidateFunc: intvalidation.StringIsNumerical,
 				},
 			},
+			"deletion_protection": deletionProtectionSchema(),
 			"monitored_resource": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -64,17 +73,20 @@ func resourceStatusCakeHeartbeatCheck() *schema.Resource {
 				Description:  "Name of the check",
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
+			"manage_paused": managePausedSchema(),
+			"on_destroy":    onDestroySchema(),
 			"paused": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     false,
-				Description: "Whether the check should be run",
+				Description: "Whether the check should be run. Ignored when `manage_paused` is disabled",
 			},
 			"period": {
-				Type:         schema.TypeInt,
+				Type:         schema.TypeString,
 				Required:     true,
-				Description:  "Number of seconds since the last ping before the check is considered down.",
-				ValidateFunc: validation.IntBetween(30, 172800),
+				Description:  "Number of seconds since the last ping before the check is considered down. May also be given as a duration such as `1h`",
+				StateFunc:    normalizeDuration(time.Second),
+				ValidateFunc: intvalidation.DurationBetween(30, 172800, time.Second),
 			},
 			"tags": {
 				Type:        schema.TypeSet,
@@ -87,73 +99,85 @@ func resourceStatusCakeHeartbeatCheck() *schema.Resource {
 			},
 		},
 	}
+
+	// Used to upgrade the state stored by prior versions of the schema.
+	return withStateUpgrades(r)
 }
 
 func resourceStatusCakeHeartbeatCheckCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*statuscake.Client)
+	client := meta.(*providerConfig).client
 	body := make(map[string]interface{})
 
 	contactGroups, err := expandHeartbeatCheckContactGroups(d.Get("contact_groups"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("contact_groups") {
+	} else if hasChange(d, "contact_groups") {
 		body["contact_groups"] = contactGroups
 	}
 
 	monitoredResource, err := expandHeartbeatCheckMonitoredResource(d.Get("monitored_resource"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("monitored_resource") {
+	} else if hasChange(d, "monitored_resource") {
 		body = merge(body, monitoredResource.(map[string]interface{}))
 	}
 
 	name, err := expandHeartbeatCheckName(d.Get("name"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("name") {
+	} else if hasChange(d, "name") {
 		body["name"] = name
 	}
 
 	paused, err := expandHeartbeatCheckPaused(d.Get("paused"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("paused") {
+	} else if hasChange(d, "paused", "manage_paused") && d.Get("manage_paused").(bool) {
 		body["paused"] = paused
 	}
 
 	period, err := expandHeartbeatCheckPeriod(d.Get("period"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("period") {
+	} else if hasChange(d, "period") {
 		body["period"] = period
 	}
 
 	tags, err := expandHeartbeatCheckTags(d.Get("tags"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("tags") {
+	} else if hasChange(d, "tags") {
 		body["tags"] = tags
 	}
 
-	log.Printf("[DEBUG] Request body: %+v", body)
+	find := func() (string, error) {
+		return findHeartbeatCheck(ctx, client, d.Get("name").(string))
+	}
 
-	res, err := client.CreateHeartbeatTestWithData(ctx, body).Execute()
-	if err != nil {
-		return intdiag.FromErr("failed to create heartbeat check", err)
+	create := func() (string, error) {
+		res, err := client.CreateHeartbeatTestWithData(ctx, body).Execute()
+		return res.Data.NewID, err
 	}
 
-	d.SetId(res.Data.NewID)
+	update := func(id string) error {
+		return client.UpdateHeartbeatTestWithData(ctx, id, body).Execute()
+	}
+
+	if diags := createOrAdopt(d, "heartbeat check", body, find, create, update); diags.HasError() {
+		return diags
+	}
 	return resourceStatusCakeHeartbeatCheckRead(ctx, d, meta)
 }
 
 func resourceStatusCakeHeartbeatCheckRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*statuscake.Client)
+	client := meta.(*providerConfig).client
 	id := d.Id()
 
-	res, err := client.GetHeartbeatTest(ctx, id).Execute()
+	res, err := retryNotFound(ctx, d, client.GetHeartbeatTest(ctx, id).Execute)
 
-	// If the resource is not found then remove it from the state.
-	if err, ok := err.(statuscake.APIError); ok && err.Status == http.StatusNotFound {
+	// If the resource is not found then remove it from the state. A new resource
+	// that cannot be found is an error.
+	if isNotFound(err) && !d.IsNewResource() {
 		d.SetId("")
 		return nil
 	}
@@ -173,8 +197,17 @@ func resourceStatusCakeHeartbeatCheckRead(ctx context.Context, d *schema.Resourc
 		return diag.Errorf("failed to read name: %s", err)
 	}
 
-	if err := d.Set("paused", flattenHeartbeatCheckPaused(res.Data.Paused, d)); err != nil {
-		return diag.Errorf("failed to read paused: %s", err)
+	// The paused state is retained when it is not managed by Terraform so that
+	// changes made outside of Terraform are not reported as drift.
+	managed := managePaused(d, meta)
+	if err := d.Set("manage_paused", managed); err != nil {
+		return diag.Errorf("failed to read manage paused: %s", err)
+	}
+
+	if managed {
+		if err := d.Set("paused", flattenHeartbeatCheckPaused(res.Data.Paused, d)); err != nil {
+			return diag.Errorf("failed to read paused: %s", err)
+		}
 	}
 
 	if err := d.Set("period", flattenHeartbeatCheckPeriod(res.Data.Period, d)); err != nil {
@@ -193,7 +226,7 @@ func resourceStatusCakeHeartbeatCheckRead(ctx context.Context, d *schema.Resourc
 }
 
 func resourceStatusCakeHeartbeatCheckUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*statuscake.Client)
+	client := meta.(*providerConfig).client
 	body := make(map[string]interface{})
 	id := d.Id()
 
@@ -221,7 +254,7 @@ func resourceStatusCakeHeartbeatCheckUpdate(ctx context.Context, d *schema.Resou
 	paused, err := expandHeartbeatCheckPaused(d.Get("paused"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("paused") {
+	} else if d.HasChanges("paused", "manage_paused") && d.Get("manage_paused").(bool) {
 		body["paused"] = paused
 	}
 
@@ -239,6 +272,13 @@ func resourceStatusCakeHeartbeatCheckUpdate(ctx context.Context, d *schema.Resou
 		body["tags"] = tags
 	}
 
+	// Attributes such as deletion_protection and manage_paused only change the
+	// behaviour of the provider, so changing them alone does not update the
+	// check.
+	if len(body) == 0 {
+		return resourceStatusCakeHeartbeatCheckRead(ctx, d, meta)
+	}
+
 	log.Printf("[DEBUG] Updating StatusCake heartbeat check with ID: %s", id)
 	log.Printf("[DEBUG] Request body: %+v", body)
 
@@ -250,12 +290,27 @@ func resourceStatusCakeHeartbeatCheckUpdate(ctx context.Context, d *schema.Resou
 }
 
 func resourceStatusCakeHeartbeatCheckDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*statuscake.Client)
+	client := meta.(*providerConfig).client
 	id := d.Id()
 
+	pause, diags := checkDeletion(d, "heartbeat check")
+	if diags.HasError() {
+		return diags
+	}
+
+	if pause {
+		log.Printf("[DEBUG] Pausing StatusCake heartbeat check with ID: %s instead of deleting it", id)
+
+		if err := client.UpdateHeartbeatTest(ctx, id).Paused(true).Execute(); err != nil && !isNotFound(err) {
+			return intdiag.FromErr(fmt.Sprintf("failed to pause heartbeat check with id %s", id), err)
+		}
+
+		return nil
+	}
+
 	log.Printf("[DEBUG] Deleting StatusCake heartbeat check with ID: %s", id)
 
-	if err := client.DeleteHeartbeatTest(ctx, id).Execute(); err != nil {
+	if err := client.DeleteHeartbeatTest(ctx, id).Execute(); err != nil && !isNotFound(err) {
 		return intdiag.FromErr(fmt.Sprintf("failed to delete heartbeat check with id %s", id), err)
 	}
 
@@ -300,7 +355,7 @@ func expandHeartbeatCheckMonitoredResource(v interface{}, d *schema.ResourceData
 	host, err := expandHeartbeatCheckHost(original["host"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("monitored_resource.0.host") {
+	} else if hasChange(d, "monitored_resource.0.host") {
 		transformed["host"] = host
 	}
 
@@ -339,11 +394,15 @@ func flattenHeartbeatCheckPaused(v interface{}, d *schema.ResourceData) interfac
 }
 
 func expandHeartbeatCheckPeriod(v interface{}, d *schema.ResourceData) (interface{}, error) {
-	return int32(v.(int)), nil
+	n, err := intvalidation.ParseDuration(v.(string), time.Second)
+	if err != nil {
+		return nil, err
+	}
+	return int32(n), nil
 }
 
 func flattenHeartbeatCheckPeriod(v interface{}, d *schema.ResourceData) interface{} {
-	return v
+	return strconv.Itoa(int(v.(int32)))
 }
 
 func flattenHeartbeatCheckURL(v interface{}, d *schema.ResourceData) interface{} {
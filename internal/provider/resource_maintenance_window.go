@@ -4,11 +4,14 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"net/http"
+	"slices"
+	"sort"
 	"time"
 
 	"github.com/StatusCakeDev/statuscake-go"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -16,24 +19,52 @@ import (
 	intvalidation "github.com/StatusCakeDev/terraform-provider-statuscake/v2/internal/provider/validation"
 )
 
+const (
+	maintenanceWindowStateActive  = "active"
+	maintenanceWindowStateEnded   = "ended"
+	maintenanceWindowStatePending = "pending"
+)
+
 func resourceStatusCakeMaintenanceWindow() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		CreateContext: resourceStatusCakeMaintenanceWindowCreate,
 		ReadContext:   resourceStatusCakeMaintenanceWindowRead,
 		UpdateContext: resourceStatusCakeMaintenanceWindowUpdate,
 		DeleteContext: resourceStatusCakeMaintenanceWindowDelete,
 
 		// Used by `terraform import`.
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer: importer("statuscake_maintenance_window"),
+
+		CustomizeDiff: customdiff.All(
+			resourceStatusCakeMaintenanceWindowLocalTimeDiff,
+			resourceStatusCakeMaintenanceWindowDurationDiff,
+			resourceStatusCakeMaintenanceWindowPeriodDiff,
+			resourceStatusCakeMaintenanceWindowResolvedTestsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
+			"duration": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Duration of the maintenance window, such as `45m`. Used to compute the end of the maintenance window. Only one of `duration`, `end`, or `end_local` may be specified",
+				ValidateFunc: intvalidation.IsPositiveDuration,
+				ExactlyOneOf: []string{"duration", "end", "end_local"},
+			},
 			"end": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "End of the maintenance window (RFC3339 format). Only one of `duration`, `end`, or `end_local` may be specified",
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentTime,
+				ExactlyOneOf:     []string{"duration", "end", "end_local"},
+			},
+			"end_local": {
 				Type:         schema.TypeString,
-				Required:     true,
-				Description:  "End of the maintenance window (RFC3339 format)",
-				ValidateFunc: validation.IsRFC3339Time,
+				Optional:     true,
+				Description:  "End of the maintenance window as a wall clock date and time within `timezone`, such as `2026-11-01T04:00`. Times skipped or repeated by a daylight saving transition are rejected. Only one of `duration`, `end`, or `end_local` may be specified",
+				ValidateFunc: intvalidation.IsLocalDateTime,
+				ExactlyOneOf: []string{"duration", "end", "end_local"},
 			},
 			"name": {
 				Type:         schema.TypeString,
@@ -41,6 +72,16 @@ func resourceStatusCakeMaintenanceWindow() *schema.Resource {
 				Description:  "Name of the maintenance window",
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
+			"next_end": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "End of the current or next occurrence of the maintenance window (RFC3339 format). Empty once the maintenance window has ended",
+			},
+			"next_start": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Start of the current or next occurrence of the maintenance window (RFC3339 format). Empty once the maintenance window has ended",
+			},
 			"repeat_interval": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -48,11 +89,34 @@ func resourceStatusCakeMaintenanceWindow() *schema.Resource {
 				Description:  "How often the maintenance window should occur",
 				ValidateFunc: validation.StringInSlice(statuscake.MaintenanceWindowRepeatIntervalValues(), false),
 			},
+			"resolved_tests": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "List of uptime check IDs included in this maintenance window, either explicitly or by matching tags",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"start": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Start of the maintenance window (RFC3339 format). May also be given relative to the time the maintenance window is created, such as `now` or `now+15m`. Only one of `start` or `start_local` may be specified",
+				ValidateFunc:     intvalidation.IsRFC3339OrRelativeTime,
+				DiffSuppressFunc: suppressMaintenanceWindowStart,
+				ExactlyOneOf:     []string{"start", "start_local"},
+			},
+			"start_local": {
 				Type:         schema.TypeString,
-				Required:     true,
-				Description:  "Start of the maintenance window (RFC3339 format)",
-				ValidateFunc: validation.IsRFC3339Time,
+				Optional:     true,
+				Description:  "Start of the maintenance window as a wall clock date and time within `timezone`, such as `2026-11-01T02:00`. Times skipped or repeated by a daylight saving transition are rejected. Only one of `start` or `start_local` may be specified",
+				ValidateFunc: intvalidation.IsLocalDateTime,
+				ExactlyOneOf: []string{"start", "start_local"},
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Whether the maintenance window is `pending`, `active`, or has `ended`",
 			},
 			"tags": {
 				Type:        schema.TypeSet,
@@ -77,21 +141,275 @@ func resourceStatusCakeMaintenanceWindow() *schema.Resource {
 			"timezone": {
 				Type:         schema.TypeString,
 				Required:     true,
-				Description:  "Standard timezone associated with this maintenance window",
-				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Standard timezone associated with this maintenance window, such as `Europe/London`",
+				ValidateFunc: intvalidation.IsTimezone,
 			},
 		},
 	}
+
+	// Used to upgrade the state stored by prior versions of the schema.
+	return withStateUpgrades(r)
+}
+
+// resourceStatusCakeMaintenanceWindowLocalTimeDiff plans the start and end of
+// the maintenance window when they are given as wall clock times so that the
+// resulting instants are shown in the plan and can be validated. Both are
+// planned together since the API may have advanced a repeating maintenance
+// window beyond the previously configured occurrence. Wall clock times that
+// are skipped or repeated by a daylight saving transition are rejected.
+func resourceStatusCakeMaintenanceWindowLocalTimeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("timezone") || !d.HasChanges("start_local", "end_local", "timezone") {
+		return nil
+	}
+
+	for _, key := range []string{"start", "end"} {
+		local := key + "_local"
+		if !d.NewValueKnown(local) {
+			continue
+		}
+
+		v := d.Get(local).(string)
+		if v == "" {
+			continue
+		}
+
+		t, err := expandMaintenanceWindowLocalTime(v, d.Get("timezone").(string))
+		if err != nil {
+			return fmt.Errorf("%s: %w", local, err)
+		}
+
+		if err := d.SetNew(key, t.Format(time.RFC3339)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resourceStatusCakeMaintenanceWindowDurationDiff plans the end of the
+// maintenance window when it is given as a duration. The end is unknown until
+// apply when the start is relative to the time the maintenance window is
+// created.
+func resourceStatusCakeMaintenanceWindowDurationDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.HasChanges("duration", "start", "start_local", "timezone") {
+		return nil
+	}
+
+	v := d.Get("duration").(string)
+	if d.NewValueKnown("duration") && v == "" {
+		return nil
+	}
+
+	if !d.NewValueKnown("duration") || !d.NewValueKnown("start") {
+		return d.SetNewComputed("end")
+	}
+
+	// Invalid durations are reported by the schema validation.
+	duration, err := time.ParseDuration(v)
+	if err != nil {
+		return nil
+	}
+
+	start, err := time.Parse(time.RFC3339, d.Get("start").(string))
+	if err != nil {
+		// A relative start is resolved when the maintenance window is created
+		// and retained thereafter.
+		old, _ := d.GetChange("start")
+		if start, err = time.Parse(time.RFC3339, old.(string)); err != nil {
+			return d.SetNewComputed("end")
+		}
+	}
+
+	return d.SetNew("end", start.Add(duration).Format(time.RFC3339))
+}
+
+// resourceStatusCakeMaintenanceWindowPeriodDiff ensures the maintenance window
+// ends after it starts.
+func resourceStatusCakeMaintenanceWindowPeriodDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("start") || !d.NewValueKnown("end") {
+		return nil
+	}
+
+	// Invalid times are reported by the schema validation.
+	start, err := time.Parse(time.RFC3339, d.Get("start").(string))
+	if err != nil {
+		return nil
+	}
+	end, err := time.Parse(time.RFC3339, d.Get("end").(string))
+	if err != nil {
+		return nil
+	}
+
+	if !end.After(start) {
+		return fmt.Errorf("end: must be after start (%s), got %s", start.Format(time.RFC3339), end.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// maintenanceWindowPeriodDiagnostics returns a warning when a repeating
+// maintenance window is longer than its repeat interval since consecutive
+// occurrences then overlap.
+func maintenanceWindowPeriodDiagnostics(w statuscake.MaintenanceWindow) diag.Diagnostics {
+	next, ok := nextMaintenanceWindowOccurrence(w.Start, w.RepeatInterval)
+	if !ok || !w.End.After(next) {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity:      diag.Warning,
+		Summary:       "Maintenance window is longer than its repeat interval",
+		Detail:        fmt.Sprintf("The maintenance window lasting %s is longer than its repeat interval %q, so consecutive occurrences overlap.", w.End.Sub(w.Start), w.RepeatInterval),
+		AttributePath: cty.GetAttrPath("end"),
+	}}
+}
+
+// nextMaintenanceWindowOccurrence returns the time of the occurrence following
+// t for the given repeat interval. It returns false if the maintenance window
+// does not repeat.
+func nextMaintenanceWindowOccurrence(t time.Time, interval statuscake.MaintenanceWindowRepeatInterval) (time.Time, bool) {
+	switch interval {
+	case statuscake.MaintenanceWindowRepeatIntervalDaily:
+		return t.AddDate(0, 0, 1), true
+	case statuscake.MaintenanceWindowRepeatIntervalWeekly:
+		return t.AddDate(0, 0, 7), true
+	case statuscake.MaintenanceWindowRepeatIntervalBiweekly:
+		return t.AddDate(0, 0, 14), true
+	case statuscake.MaintenanceWindowRepeatIntervalMonthly:
+		return t.AddDate(0, 1, 0), true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// resourceStatusCakeMaintenanceWindowResolvedTestsDiff marks the resolved tests
+// as unknown when the tags or tests included in the maintenance window change.
+// Tags that do not match any uptime check are reported as warnings when the
+// maintenance window is read, including once it has been created or updated,
+// since warnings cannot be returned when planning.
+func resourceStatusCakeMaintenanceWindowResolvedTestsDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.HasChanges("tags", "tests") {
+		return nil
+	}
+
+	return d.SetNewComputed("resolved_tests")
+}
+
+// resolveMaintenanceWindowTests returns the IDs of the uptime checks included
+// in a maintenance window, either explicitly or because they have one of the
+// given tags. The tags not matching any uptime check are also returned.
+func resolveMaintenanceWindowTests(checks []statuscake.UptimeTestOverview, tags, tests []string) ([]string, []string) {
+	resolved := make(map[string]bool)
+	for _, id := range tests {
+		resolved[id] = true
+	}
+
+	matched := make(map[string]bool)
+	for _, check := range checks {
+		for _, tag := range check.Tags {
+			if slices.Contains(tags, tag) {
+				resolved[check.ID] = true
+				matched[tag] = true
+			}
+		}
+	}
+
+	var unmatched []string
+	for _, tag := range tags {
+		if !matched[tag] {
+			unmatched = append(unmatched, tag)
+		}
+	}
+
+	ids := make([]string, 0, len(resolved))
+	for id := range resolved {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	sort.Strings(unmatched)
+
+	return ids, unmatched
+}
+
+// maintenanceWindowSchedule returns the state of the maintenance window at the
+// given time along with the start and end of its current or next occurrence.
+// Occurrences are computed within the time zone of the maintenance window so
+// that they retain their wall clock time across daylight saving transitions.
+// Zero times are returned once the maintenance window has ended.
+func maintenanceWindowSchedule(w statuscake.MaintenanceWindow, now time.Time) (string, time.Time, time.Time) {
+	start, end := w.Start, w.End
+	if loc, err := time.LoadLocation(w.Timezone); err == nil {
+		start, end = start.In(loc), end.In(loc)
+	}
+
+	for !end.After(now) {
+		nextStart, ok := nextMaintenanceWindowOccurrence(start, w.RepeatInterval)
+		if !ok {
+			return maintenanceWindowStateEnded, time.Time{}, time.Time{}
+		}
+
+		nextEnd, _ := nextMaintenanceWindowOccurrence(end, w.RepeatInterval)
+		start, end = nextStart, nextEnd
+	}
+
+	if start.After(now) {
+		return maintenanceWindowStatePending, start, end
+	}
+	return maintenanceWindowStateActive, start, end
+}
+
+// suppressMaintenanceWindowStart is a schema.SchemaDiffSuppressFunc that
+// suppresses differences between equivalent start times. A relative start is
+// resolved only when the maintenance window is created and so differences
+// between it and the resolved start are also suppressed.
+func suppressMaintenanceWindowStart(k, old, new string, d *schema.ResourceData) bool {
+	if _, relative, _ := intvalidation.ParseRelativeTime(new, time.Now()); relative {
+		_, err := time.Parse(time.RFC3339, old)
+		return err == nil
+	}
+	return suppressEquivalentTime(k, old, new, d)
+}
+
+// suppressEquivalentTime is a schema.SchemaDiffSuppressFunc that suppresses
+// differences between RFC3339 times representing the same instant, such as
+// "2026-11-01T03:00:00+01:00" and "2026-11-01T02:00:00Z".
+func suppressEquivalentTime(_, old, new string, _ *schema.ResourceData) bool {
+	o, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	n, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return o.Equal(n)
 }
 
 func resourceStatusCakeMaintenanceWindowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*statuscake.Client)
+	client := meta.(*providerConfig).client
 	body := make(map[string]interface{})
 
-	end, err := expandMaintenanceWindowEnd(d.Get("end"), d)
+	// The start is resolved once so that the end computed from a duration is
+	// relative to the same instant.
+	start, resolved, err := expandMaintenanceWindowStart(d.Get("start"), d, time.Now().Truncate(time.Second))
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("end") {
+	} else if d.HasChanges("start", "start_local", "end_local", "timezone") {
+		body["start_at"] = start
+	}
+
+	// A relative start is stored once resolved so that it is retained
+	// thereafter.
+	if resolved {
+		if err := d.Set("start", start.Format(time.RFC3339)); err != nil {
+			return diag.Errorf("failed to set start: %s", err)
+		}
+	}
+
+	end, err := expandMaintenanceWindowEnd(d.Get("end"), d, start)
+	if err != nil {
+		return diag.FromErr(err)
+	} else if d.HasChanges("duration", "end", "start", "start_local", "end_local", "timezone") {
 		body["end_at"] = end
 	}
 
@@ -109,13 +427,6 @@ func resourceStatusCakeMaintenanceWindowCreate(ctx context.Context, d *schema.Re
 		body["repeat_interval"] = interval
 	}
 
-	start, err := expandMaintenanceWindowStart(d.Get("start"), d)
-	if err != nil {
-		return diag.FromErr(err)
-	} else if d.HasChange("start") {
-		body["start_at"] = start
-	}
-
 	tags, err := expandMaintenanceWindowTags(d.Get("tags"), d)
 	if err != nil {
 		return diag.FromErr(err)
@@ -150,13 +461,14 @@ func resourceStatusCakeMaintenanceWindowCreate(ctx context.Context, d *schema.Re
 }
 
 func resourceStatusCakeMaintenanceWindowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*statuscake.Client)
+	client := meta.(*providerConfig).client
 	id := d.Id()
 
-	res, err := client.GetMaintenanceWindow(ctx, id).Execute()
+	res, err := retryNotFound(ctx, d, client.GetMaintenanceWindow(ctx, id).Execute)
 
-	// If the resource is not found then remove it from the state.
-	if err, ok := err.(statuscake.APIError); ok && err.Status == http.StatusNotFound {
+	// If the resource is not found then remove it from the state. A new resource
+	// that cannot be found is an error.
+	if isNotFound(err) && !d.IsNewResource() {
 		d.SetId("")
 		return nil
 	}
@@ -168,6 +480,10 @@ func resourceStatusCakeMaintenanceWindowRead(ctx context.Context, d *schema.Reso
 		return diag.Errorf("failed to read end: %s", err)
 	}
 
+	if err := d.Set("end_local", flattenMaintenanceWindowEndLocal(res.Data, d)); err != nil {
+		return diag.Errorf("failed to read end local: %s", err)
+	}
+
 	if err := d.Set("name", flattenMaintenanceWindowName(res.Data.Name, d)); err != nil {
 		return diag.Errorf("failed to read name: %s", err)
 	}
@@ -176,10 +492,55 @@ func resourceStatusCakeMaintenanceWindowRead(ctx context.Context, d *schema.Reso
 		return diag.Errorf("failed to read repeat interval: %s", err)
 	}
 
+	// Failing to list the uptime checks only prevents the checks covered by tag
+	// from being resolved and so the previously resolved tests are retained.
+	var diags diag.Diagnostics
+	checks, err := meta.(*providerConfig).uptimeChecks.List(ctx, client)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Failed to resolve the uptime checks covered by maintenance window",
+			Detail:   fmt.Sprintf("The uptime checks covered by the tags of maintenance window %s could not be resolved: %s", id, err),
+		})
+	} else {
+		resolved, unmatched := resolveMaintenanceWindowTests(checks, res.Data.Tags, res.Data.Tests)
+		if err := d.Set("resolved_tests", flattenMaintenanceWindowResolvedTests(resolved, d)); err != nil {
+			return diag.Errorf("failed to read resolved tests: %s", err)
+		}
+
+		for _, tag := range unmatched {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Maintenance window tag does not match any uptime check",
+				Detail:   fmt.Sprintf("The tag %q of maintenance window %s does not match any uptime check. The maintenance window does not cover any check by this tag.", tag, id),
+			})
+		}
+	}
+
 	if err := d.Set("start", flattenMaintenanceWindowStart(res.Data.Start, d)); err != nil {
 		return diag.Errorf("failed to read start: %s", err)
 	}
 
+	if err := d.Set("start_local", flattenMaintenanceWindowStartLocal(res.Data, d)); err != nil {
+		return diag.Errorf("failed to read start local: %s", err)
+	}
+
+	diags = append(diags, maintenanceWindowPeriodDiagnostics(res.Data)...)
+
+	state, nextStart, nextEnd := maintenanceWindowSchedule(res.Data, time.Now())
+
+	if err := d.Set("next_end", flattenMaintenanceWindowNextEnd(nextEnd, d)); err != nil {
+		return diag.Errorf("failed to read next end: %s", err)
+	}
+
+	if err := d.Set("next_start", flattenMaintenanceWindowNextStart(nextStart, d)); err != nil {
+		return diag.Errorf("failed to read next start: %s", err)
+	}
+
+	if err := d.Set("state", flattenMaintenanceWindowState(state, d)); err != nil {
+		return diag.Errorf("failed to read state: %s", err)
+	}
+
 	if err := d.Set("tags", flattenMaintenanceWindowTags(res.Data.Tags, d)); err != nil {
 		return diag.Errorf("failed to read tags: %s", err)
 	}
@@ -192,18 +553,25 @@ func resourceStatusCakeMaintenanceWindowRead(ctx context.Context, d *schema.Reso
 		return diag.Errorf("failed to read timezone: %s", err)
 	}
 
-	return nil
+	return diags
 }
 
 func resourceStatusCakeMaintenanceWindowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*statuscake.Client)
+	client := meta.(*providerConfig).client
 	body := make(map[string]interface{})
 	id := d.Id()
 
-	end, err := expandMaintenanceWindowEnd(d.Get("end"), d)
+	start, _, err := expandMaintenanceWindowStart(d.Get("start"), d, time.Now().Truncate(time.Second))
+	if err != nil {
+		return diag.FromErr(err)
+	} else if d.HasChanges("start", "start_local", "end_local", "timezone") {
+		body["start_at"] = start
+	}
+
+	end, err := expandMaintenanceWindowEnd(d.Get("end"), d, start)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("end") {
+	} else if d.HasChanges("duration", "end", "start", "start_local", "end_local", "timezone") {
 		body["end_at"] = end
 	}
 
@@ -221,13 +589,6 @@ func resourceStatusCakeMaintenanceWindowUpdate(ctx context.Context, d *schema.Re
 		body["repeat_interval"] = interval
 	}
 
-	start, err := expandMaintenanceWindowStart(d.Get("start"), d)
-	if err != nil {
-		return diag.FromErr(err)
-	} else if d.HasChange("start") {
-		body["start_at"] = start
-	}
-
 	tags, err := expandMaintenanceWindowTags(d.Get("tags"), d)
 	if err != nil {
 		return diag.FromErr(err)
@@ -260,25 +621,37 @@ func resourceStatusCakeMaintenanceWindowUpdate(ctx context.Context, d *schema.Re
 }
 
 func resourceStatusCakeMaintenanceWindowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*statuscake.Client)
+	client := meta.(*providerConfig).client
 	id := d.Id()
 
 	log.Printf("[DEBUG] Deleting StatusCake maintenance window with ID: %s", id)
 
-	if err := client.DeleteMaintenanceWindow(ctx, id).Execute(); err != nil {
+	if err := client.DeleteMaintenanceWindow(ctx, id).Execute(); err != nil && !isNotFound(err) {
 		return intdiag.FromErr(fmt.Sprintf("failed to delete maintenance window with id %s", id), err)
 	}
 
 	return nil
 }
 
-func expandMaintenanceWindowEnd(v interface{}, d *schema.ResourceData) (interface{}, error) {
+// expandMaintenanceWindowEnd returns the end of the maintenance window. An end
+// given as a duration is relative to the given start.
+func expandMaintenanceWindowEnd(v interface{}, d *schema.ResourceData, start time.Time) (interface{}, error) {
+	if duration := d.Get("duration").(string); duration != "" {
+		return expandMaintenanceWindowEndFromDuration(duration, start)
+	}
+	if local := d.Get("end_local").(string); local != "" {
+		return expandMaintenanceWindowLocalTime(local, d.Get("timezone").(string))
+	}
 	return time.Parse(time.RFC3339, v.(string))
 }
 
-func flattenMaintenanceWindowEnd(v interface{}, _ *schema.ResourceData) interface{} {
-	t := v.(time.Time)
-	return t.Format(time.RFC3339)
+func flattenMaintenanceWindowEnd(v interface{}, d *schema.ResourceData) interface{} {
+	return flattenMaintenanceWindowTime(v.(time.Time), d.Get("end").(string))
+}
+
+func flattenMaintenanceWindowEndLocal(v interface{}, d *schema.ResourceData) interface{} {
+	data := v.(statuscake.MaintenanceWindow)
+	return flattenMaintenanceWindowLocalTime(data.End, d.Get("end_local").(string), data.Timezone, data.RepeatInterval)
 }
 
 func expandMaintenanceWindowName(v interface{}, d *schema.ResourceData) (interface{}, error) {
@@ -289,6 +662,23 @@ func flattenMaintenanceWindowName(v interface{}, d *schema.ResourceData) interfa
 	return v
 }
 
+func flattenMaintenanceWindowNextEnd(v interface{}, d *schema.ResourceData) interface{} {
+	return flattenMaintenanceWindowScheduleTime(v.(time.Time))
+}
+
+func flattenMaintenanceWindowNextStart(v interface{}, d *schema.ResourceData) interface{} {
+	return flattenMaintenanceWindowScheduleTime(v.(time.Time))
+}
+
+// flattenMaintenanceWindowScheduleTime returns the time in RFC3339 format, or
+// an empty string if the time is zero.
+func flattenMaintenanceWindowScheduleTime(t time.Time) interface{} {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
 func expandMaintenanceWindowRepeatInterval(v interface{}, d *schema.ResourceData) (interface{}, error) {
 	return statuscake.MaintenanceWindowRepeatInterval(v.(string)), nil
 }
@@ -297,13 +687,59 @@ func flattenMaintenanceWindowRepeatInterval(v interface{}, d *schema.ResourceDat
 	return v
 }
 
-func expandMaintenanceWindowStart(v interface{}, d *schema.ResourceData) (interface{}, error) {
-	return time.Parse(time.RFC3339, v.(string))
+func flattenMaintenanceWindowResolvedTests(v interface{}, d *schema.ResourceData) interface{} {
+	return v
 }
 
-func flattenMaintenanceWindowStart(v interface{}, _ *schema.ResourceData) interface{} {
-	t := v.(time.Time)
-	return t.Format(time.RFC3339)
+// expandMaintenanceWindowStart returns the start of the maintenance window. A
+// relative start is resolved against now when the maintenance window is
+// created, and the previously resolved start is returned thereafter. The
+// returned bool reports whether the start was resolved against now.
+func expandMaintenanceWindowStart(v interface{}, d *schema.ResourceData, now time.Time) (time.Time, bool, error) {
+	if local := d.Get("start_local").(string); local != "" {
+		t, err := expandMaintenanceWindowLocalTime(local, d.Get("timezone").(string))
+		return t, false, err
+	}
+
+	t, relative, err := intvalidation.ParseRelativeTime(v.(string), now)
+	if !relative {
+		t, err := time.Parse(time.RFC3339, v.(string))
+		return t, false, err
+	} else if err != nil {
+		return time.Time{}, false, err
+	}
+
+	if old, _ := d.GetChange("start"); old.(string) != "" {
+		if t, err := time.Parse(time.RFC3339, old.(string)); err == nil {
+			return t, false, nil
+		}
+	}
+
+	return t, true, nil
+}
+
+// expandMaintenanceWindowEndFromDuration returns the end of the maintenance
+// window computed from its start and duration.
+func expandMaintenanceWindowEndFromDuration(v string, start time.Time) (time.Time, error) {
+	duration, err := time.ParseDuration(v)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return start.Add(duration), nil
+}
+
+func flattenMaintenanceWindowStart(v interface{}, d *schema.ResourceData) interface{} {
+	return flattenMaintenanceWindowTime(v.(time.Time), d.Get("start").(string))
+}
+
+func flattenMaintenanceWindowStartLocal(v interface{}, d *schema.ResourceData) interface{} {
+	data := v.(statuscake.MaintenanceWindow)
+	return flattenMaintenanceWindowLocalTime(data.Start, d.Get("start_local").(string), data.Timezone, data.RepeatInterval)
+}
+
+func flattenMaintenanceWindowState(v interface{}, d *schema.ResourceData) interface{} {
+	return v
 }
 
 func expandMaintenanceWindowTags(v interface{}, d *schema.ResourceData) (interface{}, error) {
@@ -329,3 +765,64 @@ func expandMaintenanceWindowTimezone(v interface{}, d *schema.ResourceData) (int
 func flattenMaintenanceWindowTimezone(v interface{}, d *schema.ResourceData) interface{} {
 	return v
 }
+
+// flattenMaintenanceWindowTime returns the configured time if it represents the
+// same instant as t. The API returns times in UTC and so comparing strings
+// would report a difference for times configured with any other offset.
+func flattenMaintenanceWindowTime(t time.Time, configured string) interface{} {
+	if c, err := time.Parse(time.RFC3339, configured); err == nil && c.Equal(t) {
+		return configured
+	}
+	return t.Format(time.RFC3339)
+}
+
+// expandMaintenanceWindowLocalTime interprets a wall clock date and time within
+// the named time zone. Wall clock times that are skipped or repeated by a
+// daylight saving transition are rejected since they do not identify a single
+// instant.
+func expandMaintenanceWindowLocalTime(v, timezone string) (time.Time, error) {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to load timezone %q: %w", timezone, err)
+	}
+	return intvalidation.ParseExactLocalDateTime(v, loc)
+}
+
+// flattenMaintenanceWindowLocalTime returns the configured wall clock time if
+// it is equivalent to t. Otherwise t is returned as a wall clock time within
+// the time zone. Nothing is returned when no wall clock time is configured
+// since the window is then specified using absolute times.
+//
+// The API advances repeating maintenance windows once they end. Each
+// occurrence is computed in the time zone of the maintenance window, as
+// maintenanceWindowSchedule does, so that occurrences either side of a
+// daylight saving transition retain the configured wall clock time.
+func flattenMaintenanceWindowLocalTime(t time.Time, configured, timezone string, interval statuscake.MaintenanceWindowRepeatInterval) interface{} {
+	if configured == "" {
+		return nil
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return intvalidation.FormatLocalDateTime(t)
+	}
+
+	occurrence, err := intvalidation.ParseLocalDateTime(configured, loc)
+	if err != nil {
+		return intvalidation.FormatLocalDateTime(t.In(loc))
+	}
+
+	for !occurrence.After(t) {
+		if occurrence.Equal(t) {
+			return configured
+		}
+
+		next, ok := nextMaintenanceWindowOccurrence(occurrence, interval)
+		if !ok {
+			break
+		}
+		occurrence = next
+	}
+
+	return intvalidation.FormatLocalDateTime(t.In(loc))
+}
@@ -0,0 +1,311 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/StatusCakeDev/statuscake-go"
+)
+
+// Daylight saving time in Europe/London starts at 01:00 UTC on 29 March 2026
+// and ends at 01:00 UTC on 25 October 2026.
+const testMaintenanceWindowTimezone = "Europe/London"
+
+// mustParseTime parses an RFC3339 time, failing the test if it is invalid.
+func mustParseTime(t *testing.T, s string) time.Time {
+	t.Helper()
+
+	v, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		t.Fatalf("failed to parse time %q: %+v", s, err)
+	}
+	return v
+}
+
+func TestMaintenanceWindowSchedule(t *testing.T) {
+	tests := []struct {
+		name     string
+		start    string
+		end      string
+		interval statuscake.MaintenanceWindowRepeatInterval
+		now      string
+		state    string
+		nextFrom string
+		nextTo   string
+	}{
+		{
+			name:     "returns a pending occurrence before the window starts",
+			start:    "2026-03-28T09:00:00Z",
+			end:      "2026-03-28T10:00:00Z",
+			interval: statuscake.MaintenanceWindowRepeatIntervalNever,
+			now:      "2026-03-28T08:00:00Z",
+			state:    maintenanceWindowStatePending,
+			nextFrom: "2026-03-28T09:00:00Z",
+			nextTo:   "2026-03-28T10:00:00Z",
+		},
+		{
+			name:     "returns no occurrence once a window that does not repeat has ended",
+			start:    "2026-03-28T09:00:00Z",
+			end:      "2026-03-28T10:00:00Z",
+			interval: statuscake.MaintenanceWindowRepeatIntervalNever,
+			now:      "2026-03-28T10:00:00Z",
+			state:    maintenanceWindowStateEnded,
+		},
+		{
+			name:     "keeps the wall clock time of a daily window when daylight saving time starts",
+			start:    "2026-03-28T09:00:00Z",
+			end:      "2026-03-28T10:00:00Z",
+			interval: statuscake.MaintenanceWindowRepeatIntervalDaily,
+			now:      "2026-03-29T12:00:00Z",
+			state:    maintenanceWindowStatePending,
+			nextFrom: "2026-03-30T08:00:00Z",
+			nextTo:   "2026-03-30T09:00:00Z",
+		},
+		{
+			name:     "returns the active occurrence of a daily window after daylight saving time starts",
+			start:    "2026-03-28T09:00:00Z",
+			end:      "2026-03-28T10:00:00Z",
+			interval: statuscake.MaintenanceWindowRepeatIntervalDaily,
+			now:      "2026-03-29T08:30:00Z",
+			state:    maintenanceWindowStateActive,
+			nextFrom: "2026-03-29T08:00:00Z",
+			nextTo:   "2026-03-29T09:00:00Z",
+		},
+		{
+			name:     "keeps the wall clock time of a weekly window when daylight saving time ends",
+			start:    "2026-10-19T08:00:00Z",
+			end:      "2026-10-19T09:00:00Z",
+			interval: statuscake.MaintenanceWindowRepeatIntervalWeekly,
+			now:      "2026-10-20T00:00:00Z",
+			state:    maintenanceWindowStatePending,
+			nextFrom: "2026-10-26T09:00:00Z",
+			nextTo:   "2026-10-26T10:00:00Z",
+		},
+		{
+			name:     "keeps the wall clock time of a monthly window when daylight saving time starts",
+			start:    "2026-03-15T09:00:00Z",
+			end:      "2026-03-15T10:00:00Z",
+			interval: statuscake.MaintenanceWindowRepeatIntervalMonthly,
+			now:      "2026-03-16T00:00:00Z",
+			state:    maintenanceWindowStatePending,
+			nextFrom: "2026-04-15T08:00:00Z",
+			nextTo:   "2026-04-15T09:00:00Z",
+		},
+		{
+			name:     "keeps the wall clock times of a window spanning the end of daylight saving time",
+			start:    "2026-10-23T22:30:00Z",
+			end:      "2026-10-24T02:30:00Z",
+			interval: statuscake.MaintenanceWindowRepeatIntervalDaily,
+			now:      "2026-10-24T12:00:00Z",
+			state:    maintenanceWindowStatePending,
+			nextFrom: "2026-10-24T22:30:00Z",
+			nextTo:   "2026-10-25T03:30:00Z",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := statuscake.MaintenanceWindow{
+				Start:          mustParseTime(t, tt.start),
+				End:            mustParseTime(t, tt.end),
+				RepeatInterval: tt.interval,
+				Timezone:       testMaintenanceWindowTimezone,
+			}
+
+			state, nextStart, nextEnd := maintenanceWindowSchedule(w, mustParseTime(t, tt.now))
+			if state != tt.state {
+				t.Errorf("expected state %q but got %q", tt.state, state)
+			}
+
+			if tt.nextFrom == "" {
+				if !nextStart.IsZero() || !nextEnd.IsZero() {
+					t.Errorf("expected no occurrence but got %s to %s", nextStart, nextEnd)
+				}
+				return
+			}
+
+			if expected := mustParseTime(t, tt.nextFrom); !nextStart.Equal(expected) {
+				t.Errorf("expected next start %s but got %s", expected, nextStart)
+			}
+
+			if expected := mustParseTime(t, tt.nextTo); !nextEnd.Equal(expected) {
+				t.Errorf("expected next end %s but got %s", expected, nextEnd)
+			}
+		})
+	}
+}
+
+func TestFlattenMaintenanceWindowLocalTime(t *testing.T) {
+	tests := []struct {
+		name       string
+		t          string
+		configured string
+		interval   statuscake.MaintenanceWindowRepeatInterval
+		expected   interface{}
+	}{
+		{
+			name:       "returns nothing when no wall clock time is configured",
+			t:          "2026-03-28T09:00:00Z",
+			configured: "",
+			interval:   statuscake.MaintenanceWindowRepeatIntervalNever,
+			expected:   nil,
+		},
+		{
+			name:       "returns the configured time when it is unchanged",
+			t:          "2026-03-28T09:00:00Z",
+			configured: "2026-03-28T09:00",
+			interval:   statuscake.MaintenanceWindowRepeatIntervalNever,
+			expected:   "2026-03-28T09:00",
+		},
+		{
+			name:       "returns the configured time of a daily window advanced past the start of daylight saving time",
+			t:          "2026-03-30T08:00:00Z",
+			configured: "2026-03-28T09:00",
+			interval:   statuscake.MaintenanceWindowRepeatIntervalDaily,
+			expected:   "2026-03-28T09:00",
+		},
+		{
+			name:       "returns the configured time of a weekly window advanced past the end of daylight saving time",
+			t:          "2026-10-26T09:00:00Z",
+			configured: "2026-10-19T09:00",
+			interval:   statuscake.MaintenanceWindowRepeatIntervalWeekly,
+			expected:   "2026-10-19T09:00",
+		},
+		{
+			name:       "returns the configured time of a monthly window advanced past the start of daylight saving time",
+			t:          "2026-04-15T08:00:00Z",
+			configured: "2026-03-15T09:00",
+			interval:   statuscake.MaintenanceWindowRepeatIntervalMonthly,
+			expected:   "2026-03-15T09:00",
+		},
+		{
+			name:       "returns the local time when an occurrence differs by the daylight saving offset",
+			t:          "2026-03-30T09:00:00Z",
+			configured: "2026-03-28T09:00",
+			interval:   statuscake.MaintenanceWindowRepeatIntervalDaily,
+			expected:   "2026-03-30T10:00",
+		},
+		{
+			name:       "returns the local time when the window does not repeat",
+			t:          "2026-03-29T09:00:00Z",
+			configured: "2026-03-28T09:00",
+			interval:   statuscake.MaintenanceWindowRepeatIntervalNever,
+			expected:   "2026-03-29T10:00",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := flattenMaintenanceWindowLocalTime(mustParseTime(t, tt.t), tt.configured, testMaintenanceWindowTimezone, tt.interval)
+			if actual != tt.expected {
+				t.Errorf("expected %v but got %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestMaintenanceWindowLocalTimeRoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		local    string
+		expected string
+		err      bool
+	}{
+		{name: "a time in winter", local: "2026-01-10T09:00", expected: "2026-01-10T09:00:00Z"},
+		{name: "a time in summer", local: "2026-07-10T09:00", expected: "2026-07-10T08:00:00Z"},
+		{name: "a time with seconds", local: "2026-07-10T09:00:00", expected: "2026-07-10T08:00:00Z"},
+		{name: "a time just after daylight saving time starts", local: "2026-03-29T02:00", expected: "2026-03-29T01:00:00Z"},
+		{name: "a time skipped when daylight saving time starts", local: "2026-03-29T01:30", err: true},
+		{name: "a time repeated when daylight saving time ends", local: "2026-10-25T01:30", err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := expandMaintenanceWindowLocalTime(tt.local, testMaintenanceWindowTimezone)
+			if tt.err {
+				if err == nil {
+					t.Fatalf("expected an error but got %s", v)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+
+			if expected := mustParseTime(t, tt.expected); !v.Equal(expected) {
+				t.Errorf("expected %s but got %s", expected, v)
+			}
+
+			if actual := flattenMaintenanceWindowLocalTime(v, tt.local, testMaintenanceWindowTimezone, statuscake.MaintenanceWindowRepeatIntervalNever); actual != tt.local {
+				t.Errorf("expected %q to round trip but got %v", tt.local, actual)
+			}
+		})
+	}
+}
+
+func TestResolveMaintenanceWindowTests(t *testing.T) {
+	checks := []statuscake.UptimeTestOverview{
+		{ID: "1", Tags: []string{"production", "web"}},
+		{ID: "2", Tags: []string{"staging"}},
+		{ID: "3", Tags: []string{"production"}},
+		{ID: "4"},
+	}
+
+	tests := []struct {
+		name      string
+		tags      []string
+		tests     []string
+		resolved  []string
+		unmatched []string
+	}{
+		{
+			name:     "resolves no checks when there are no tags or tests",
+			resolved: []string{},
+		},
+		{
+			name:     "resolves checks included explicitly",
+			tests:    []string{"4", "2"},
+			resolved: []string{"2", "4"},
+		},
+		{
+			name:     "resolves checks matching any tag",
+			tags:     []string{"production", "staging"},
+			resolved: []string{"1", "2", "3"},
+		},
+		{
+			name:     "resolves checks included both explicitly and by tag once",
+			tags:     []string{"web"},
+			tests:    []string{"1", "4"},
+			resolved: []string{"1", "4"},
+		},
+		{
+			name:      "returns the tags not matching any check",
+			tags:      []string{"web", "qa", "dev"},
+			resolved:  []string{"1"},
+			unmatched: []string{"dev", "qa"},
+		},
+		{
+			name:      "includes explicit checks unknown to the listing",
+			tags:      []string{"qa"},
+			tests:     []string{"99"},
+			resolved:  []string{"99"},
+			unmatched: []string{"qa"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolved, unmatched := resolveMaintenanceWindowTests(checks, tt.tags, tt.tests)
+			if !reflect.DeepEqual(resolved, tt.resolved) {
+				t.Errorf("expected resolved tests %v but got %v", tt.resolved, resolved)
+			}
+
+			if !reflect.DeepEqual(unmatched, tt.unmatched) {
+				t.Errorf("expected unmatched tags %v but got %v", tt.unmatched, unmatched)
+			}
+		})
+	}
+}
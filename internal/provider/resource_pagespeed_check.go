@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/StatusCakeDev/statuscake-go"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -16,18 +19,26 @@ import (
 )
 
 func resourceStatusCakePagespeedCheck() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		CreateContext: resourceStatusCakePagespeedCheckCreate,
 		ReadContext:   resourceStatusCakePagespeedCheckRead,
 		UpdateContext: resourceStatusCakePagespeedCheckUpdate,
 		DeleteContext: resourceStatusCakePagespeedCheckDelete,
 
 		// Used by `terraform import`.
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer: importer("statuscake_pagespeed_check"),
+
+		// Used to ensure the region is served by at least one pagespeed
+		// monitoring location and that referenced contact groups exist, and to
+		// plan the provider default for manage_paused.
+		CustomizeDiff: customdiff.All(
+			resourceStatusCakePagespeedCheckRegionDiff,
+			contactGroupsDiff,
+			managePausedDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
+			"adopt_existing": adoptExistingSchema("name"),
 			"alert_config": {
 				Type:        schema.TypeList,
 				Required:    true,
@@ -60,10 +71,11 @@ func resourceStatusCakePagespeedCheck() *schema.Resource {
 				},
 			},
 			"check_interval": {
-				Type:         schema.TypeInt,
+				Type:         schema.TypeString,
 				Required:     true,
-				Description:  "Number of seconds between checks",
-				ValidateFunc: intvalidation.Int32InSlice(statuscake.PagespeedTestCheckRateValues()),
+				Description:  "Number of seconds between checks. May also be given as a duration such as `1h`",
+				StateFunc:    normalizeDuration(time.Second),
+				ValidateFunc: intvalidation.DurationInSlice(statuscake.PagespeedTestCheckRateValues(), time.Second),
 			},
 			"contact_groups": {
 				Type:        schema.TypeSet,
@@ -74,6 +86,7 @@ func resourceStatusCakePagespeedCheck() *schema.Resource {
 					ValidateFunc: intvalidation.StringIsNumerical,
 				},
 			},
+			"deletion_protection": deletionProtectionSchema(),
 			"location": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -87,10 +100,12 @@ func resourceStatusCakePagespeedCheck() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"address": {
-							Type:         schema.TypeString,
-							Required:     true,
-							Description:  "URL or IP address of the website under test",
-							ValidateFunc: validation.Any(validation.IsURLWithHTTPorHTTPS, validation.IsIPAddress),
+							Type:                  schema.TypeString,
+							Required:              true,
+							Description:           "URL or IP address of the website under test",
+							ValidateFunc:          validation.Any(validation.IsURLWithHTTPorHTTPS, validation.IsIPAddress),
+							DiffSuppressFunc:      suppressEquivalentAddress,
+							DiffSuppressOnRefresh: true,
 						},
 					},
 				},
@@ -101,11 +116,13 @@ func resourceStatusCakePagespeedCheck() *schema.Resource {
 				Description:  "Name of the check",
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
+			"manage_paused": managePausedSchema(),
+			"on_destroy":    onDestroySchema(),
 			"paused": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     false,
-				Description: "Whether the check should be run",
+				Description: "Whether the check should be run. Ignored when `manage_paused` is disabled",
 			},
 			"region": {
 				Type:         schema.TypeString,
@@ -115,81 +132,116 @@ func resourceStatusCakePagespeedCheck() *schema.Resource {
 			},
 		},
 	}
+
+	// Used to upgrade the state stored by prior versions of the schema.
+	return withStateUpgrades(r)
+}
+
+// resourceStatusCakePagespeedCheckRegionDiff ensures the region resolves to a
+// known pagespeed monitoring location. Locations are only listed when the
+// region has changed.
+func resourceStatusCakePagespeedCheckRegionDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("region") || !d.NewValueKnown("region") {
+		return nil
+	}
+
+	client := meta.(*providerConfig).client
+
+	regions, err := monitoringLocationsByRegion(ctx, client, listPagespeedMonitoringLocations)
+	if err != nil {
+		return err
+	}
+
+	return validateRegionCode(regions, "region", d.Get("region").(string))
 }
 
 func resourceStatusCakePagespeedCheckCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*statuscake.Client)
+	client := meta.(*providerConfig).client
 	body := make(map[string]interface{})
 
 	config, err := expandPagespeedCheckAlertConfig(d.Get("alert_config"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("alert_config") {
+	} else if hasChange(d, "alert_config") {
 		body = merge(body, config.(map[string]interface{}))
 	}
 
 	checkInterval, err := expandPagespeedCheckInterval(d.Get("check_interval"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("check_interval") {
+	} else if hasChange(d, "check_interval") {
 		body["check_rate"] = checkInterval
 	}
 
 	contactGroups, err := expandPagespeedCheckContactGroups(d.Get("contact_groups"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("contact_groups") {
+	} else if hasChange(d, "contact_groups") {
 		body["contact_groups"] = contactGroups
 	}
 
 	name, err := expandPagespeedCheckName(d.Get("name"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("name") {
+	} else if hasChange(d, "name") {
 		body["name"] = name
 	}
 
 	monitoredResource, err := expandPagespeedCheckMonitoredResource(d.Get("monitored_resource"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("monitored_resource") {
+	} else if hasChange(d, "monitored_resource") {
 		body = merge(body, monitoredResource.(map[string]interface{}))
 	}
 
 	paused, err := expandPagespeedCheckPaused(d.Get("paused"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("paused") {
+	} else if hasChange(d, "paused", "manage_paused") && d.Get("manage_paused").(bool) {
 		body["paused"] = paused
 	}
 
 	region, err := expandPagespeedCheckRegion(d.Get("region"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("region") {
+	} else if hasChange(d, "region") {
 		body["region"] = region
 	}
 
-	log.Print("[DEBUG] Creating StatusCake pagespeed check")
-	log.Printf("[DEBUG] Request body: %+v", body)
+	find := func() (string, error) {
+		return findPagespeedCheck(ctx, client, d.Get("name").(string))
+	}
 
-	res, err := client.CreatePagespeedTestWithData(ctx, body).Execute()
-	if err != nil {
-		return intdiag.FromErr("failed to create pagespeed check", err)
+	create := func() (string, error) {
+		res, err := client.CreatePagespeedTestWithData(ctx, body).Execute()
+		return res.Data.NewID, err
+	}
+
+	update := func(id string) error {
+		return client.UpdatePagespeedTestWithData(ctx, id, body).Execute()
+	}
+
+	if diags := createOrAdopt(d, "pagespeed check", body, find, create, update); diags.HasError() {
+		return diags
+	}
+
+	diags := resourceStatusCakePagespeedCheckRead(ctx, d, meta)
+	if diags.HasError() {
+		return diags
 	}
 
-	d.SetId(res.Data.NewID)
-	return resourceStatusCakePagespeedCheckRead(ctx, d, meta)
+	return append(diags, pagespeedCheckRegionDiagnostics(ctx, client, d)...)
 }
 
 func resourceStatusCakePagespeedCheckRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*statuscake.Client)
+	client := meta.(*providerConfig).client
 	id := d.Id()
 
-	res, err := client.GetPagespeedTest(ctx, id).Execute()
+	res, err := retryNotFound(ctx, d, client.GetPagespeedTest(ctx, id).Execute)
 
-	// If the resource is not found then remove it from the state.
-	if err, ok := err.(statuscake.APIError); ok && err.Status == http.StatusNotFound {
+	// If the resource is not found then remove it from the state. A new resource
+	// that cannot be found is an error.
+	if isNotFound(err) && !d.IsNewResource() {
 		d.SetId("")
 		return nil
 	}
@@ -221,15 +273,43 @@ func resourceStatusCakePagespeedCheckRead(ctx context.Context, d *schema.Resourc
 		return diag.Errorf("failed to read name: %s", err)
 	}
 
-	if err := d.Set("paused", flattenPagespeedCheckPaused(res.Data.Paused, d)); err != nil {
-		return diag.Errorf("failed to read paused: %s", err)
+	// The paused state is retained when it is not managed by Terraform so that
+	// changes made outside of Terraform are not reported as drift.
+	managed := managePaused(d, meta)
+	if err := d.Set("manage_paused", managed); err != nil {
+		return diag.Errorf("failed to read manage paused: %s", err)
+	}
+
+	if managed {
+		if err := d.Set("paused", flattenPagespeedCheckPaused(res.Data.Paused, d)); err != nil {
+			return diag.Errorf("failed to read paused: %s", err)
+		}
 	}
 
 	return nil
 }
 
+// pagespeedCheckRegionDiagnostics returns a warning if every monitoring
+// location within the region of the check reports a non-up status. Since the
+// locations must be listed it is only called when the check is created or its
+// region changes, rather than on every read.
+func pagespeedCheckRegionDiagnostics(ctx context.Context, client *statuscake.Client, d *schema.ResourceData) diag.Diagnostics {
+	region := d.Get("region").(string)
+
+	regions, err := monitoringLocationsByRegion(ctx, client, listPagespeedMonitoringLocations)
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "failed to check region status",
+			Detail:   fmt.Sprintf("The status of the monitoring locations in region %q could not be checked: %s", region, err),
+		}}
+	}
+
+	return regionCodeDiagnostics(regions, cty.GetAttrPath("region"), region)
+}
+
 func resourceStatusCakePagespeedCheckUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*statuscake.Client)
+	client := meta.(*providerConfig).client
 	body := make(map[string]interface{})
 	id := d.Id()
 
@@ -271,7 +351,7 @@ func resourceStatusCakePagespeedCheckUpdate(ctx context.Context, d *schema.Resou
 	paused, err := expandPagespeedCheckPaused(d.Get("paused"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("paused") {
+	} else if d.HasChanges("paused", "manage_paused") && d.Get("manage_paused").(bool) {
 		body["paused"] = paused
 	}
 
@@ -282,6 +362,13 @@ func resourceStatusCakePagespeedCheckUpdate(ctx context.Context, d *schema.Resou
 		body["region"] = region
 	}
 
+	// Attributes such as on_destroy and adopt_existing only change the
+	// behaviour of the provider, so changing them alone does not update the
+	// check.
+	if len(body) == 0 {
+		return resourceStatusCakePagespeedCheckRead(ctx, d, meta)
+	}
+
 	log.Printf("[DEBUG] Updating StatusCake pagespeed check with ID: %s", id)
 	log.Printf("[DEBUG] Request body: %+v", body)
 
@@ -289,16 +376,36 @@ func resourceStatusCakePagespeedCheckUpdate(ctx context.Context, d *schema.Resou
 		return intdiag.FromErr(fmt.Sprintf("failed to update pagespeed check with id %s", id), err)
 	}
 
-	return resourceStatusCakePagespeedCheckRead(ctx, d, meta)
+	diags := resourceStatusCakePagespeedCheckRead(ctx, d, meta)
+	if diags.HasError() || !d.HasChange("region") {
+		return diags
+	}
+
+	return append(diags, pagespeedCheckRegionDiagnostics(ctx, client, d)...)
 }
 
 func resourceStatusCakePagespeedCheckDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*statuscake.Client)
+	client := meta.(*providerConfig).client
 	id := d.Id()
 
+	pause, diags := checkDeletion(d, "pagespeed check")
+	if diags.HasError() {
+		return diags
+	}
+
+	if pause {
+		log.Printf("[DEBUG] Pausing StatusCake pagespeed check with ID: %s instead of deleting it", id)
+
+		if err := client.UpdatePagespeedTest(ctx, id).Paused(true).Execute(); err != nil && !isNotFound(err) {
+			return intdiag.FromErr(fmt.Sprintf("failed to pause pagespeed check with id %s", id), err)
+		}
+
+		return nil
+	}
+
 	log.Printf("[DEBUG] Deleting StatusCake pagespeed check with ID: %s", id)
 
-	if err := client.DeletePagespeedTest(ctx, id).Execute(); err != nil {
+	if err := client.DeletePagespeedTest(ctx, id).Execute(); err != nil && !isNotFound(err) {
 		return intdiag.FromErr(fmt.Sprintf("failed to delete pagespeed check with id %s", id), err)
 	}
 
@@ -334,21 +441,21 @@ func expandPagespeedCheckAlertConfig(v interface{}, d *schema.ResourceData) (int
 	bigger, err := expandPagespeedCheckAlertBigger(original["alert_bigger"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("alert_config.0.alert_bigger") {
+	} else if hasChange(d, "alert_config.0.alert_bigger") {
 		transformed["alert_bigger"] = bigger
 	}
 
 	slower, err := expandPagespeedCheckAlertSlower(original["alert_slower"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("alert_config.0.alert_slower") {
+	} else if hasChange(d, "alert_config.0.alert_slower") {
 		transformed["alert_slower"] = slower
 	}
 
 	smaller, err := expandPagespeedCheckAlertSmaller(original["alert_smaller"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("alert_config.0.alert_smaller") {
+	} else if hasChange(d, "alert_config.0.alert_smaller") {
 		transformed["alert_smaller"] = smaller
 	}
 
@@ -383,11 +490,15 @@ func flattenPagespeedCheckAlertSmaller(v interface{}, d *schema.ResourceData) in
 }
 
 func expandPagespeedCheckInterval(v interface{}, d *schema.ResourceData) (interface{}, error) {
-	return statuscake.PagespeedTestCheckRate(v.(int)), nil
+	n, err := intvalidation.ParseDuration(v.(string), time.Second)
+	if err != nil {
+		return nil, err
+	}
+	return statuscake.PagespeedTestCheckRate(n), nil
 }
 
 func flattenPagespeedCheckInterval(v interface{}, d *schema.ResourceData) interface{} {
-	return int(v.(statuscake.PagespeedTestCheckRate))
+	return strconv.Itoa(int(v.(statuscake.PagespeedTestCheckRate)))
 }
 
 func expandPagespeedCheckContactGroups(v interface{}, d *schema.ResourceData) (interface{}, error) {
@@ -415,7 +526,7 @@ func expandPagespeedCheckMonitoredResource(v interface{}, d *schema.ResourceData
 	address, err := expandPagespeedCheckAddress(original["address"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("monitored_resource.0.address") {
+	} else if hasChange(d, "monitored_resource.0.address") {
 		transformed["website_url"] = address
 	}
 
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/StatusCakeDev/statuscake-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -16,18 +18,24 @@ import (
 )
 
 func resourceStatusCakeSSLCheck() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		CreateContext: resourceStatusCakeSSLCheckCreate,
 		ReadContext:   resourceStatusCakeSSLCheckRead,
 		UpdateContext: resourceStatusCakeSSLCheckUpdate,
 		DeleteContext: resourceStatusCakeSSLCheckDelete,
 
 		// Used by `terraform import`.
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer: importer("statuscake_ssl_check"),
+
+		// Used to ensure referenced contact groups exist and to plan the
+		// provider default for manage_paused.
+		CustomizeDiff: customdiff.All(
+			contactGroupsDiff,
+			managePausedDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
+			"adopt_existing": adoptExistingSchema("monitored address"),
 			"alert_config": {
 				Type:        schema.TypeList,
 				Required:    true,
@@ -74,10 +82,11 @@ func resourceStatusCakeSSLCheck() *schema.Resource {
 				},
 			},
 			"check_interval": {
-				Type:         schema.TypeInt,
+				Type:         schema.TypeString,
 				Required:     true,
-				Description:  "Number of seconds between checks",
-				ValidateFunc: intvalidation.Int32InSlice(statuscake.SSLTestCheckRateValues()),
+				Description:  "Number of seconds between checks. May also be given as a duration such as `1h`",
+				StateFunc:    normalizeDuration(time.Second),
+				ValidateFunc: intvalidation.DurationInSlice(statuscake.SSLTestCheckRateValues(), time.Second),
 			},
 			"contact_groups": {
 				Type:        schema.TypeSet,
@@ -88,6 +97,7 @@ func resourceStatusCakeSSLCheck() *schema.Resource {
 					ValidateFunc: intvalidation.StringIsNumerical,
 				},
 			},
+			"deletion_protection": deletionProtectionSchema(),
 			"follow_redirects": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -102,10 +112,12 @@ func resourceStatusCakeSSLCheck() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"address": {
-							Type:         schema.TypeString,
-							Required:     true,
-							Description:  "URL of the server under test",
-							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+							Type:                  schema.TypeString,
+							Required:              true,
+							Description:           "URL of the server under test",
+							ValidateFunc:          validation.IsURLWithHTTPorHTTPS,
+							DiffSuppressFunc:      suppressEquivalentAddress,
+							DiffSuppressOnRefresh: true,
 						},
 						"hostname": {
 							Type:         schema.TypeString,
@@ -116,11 +128,13 @@ func resourceStatusCakeSSLCheck() *schema.Resource {
 					},
 				},
 			},
+			"manage_paused": managePausedSchema(),
+			"on_destroy":    onDestroySchema(),
 			"paused": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     false,
-				Description: "Whether the check should be run",
+				Description: "Whether the check should be run. Ignored when `manage_paused` is disabled",
 			},
 			"user_agent": {
 				Type:         schema.TypeString,
@@ -130,81 +144,92 @@ func resourceStatusCakeSSLCheck() *schema.Resource {
 			},
 		},
 	}
+
+	// Used to upgrade the state stored by prior versions of the schema.
+	return withStateUpgrades(r)
 }
 
 func resourceStatusCakeSSLCheckCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*statuscake.Client)
+	client := meta.(*providerConfig).client
 	body := make(map[string]interface{})
 
 	config, err := expandSSLCheckAlertConfig(d.Get("alert_config"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("alert_config") {
+	} else if hasChange(d, "alert_config") {
 		body = merge(body, config.(map[string]interface{}))
 	}
 
 	checkInterval, err := expandSSLCheckInterval(d.Get("check_interval"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("check_interval") {
+	} else if hasChange(d, "check_interval") {
 		body["check_rate"] = checkInterval
 	}
 
 	contactGroups, err := expandSSLCheckContactGroups(d.Get("contact_groups"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("contact_groups") {
+	} else if hasChange(d, "contact_groups") {
 		body["contact_groups"] = contactGroups
 	}
 
 	followRedirects, err := expandSSLCheckFollowRedirects(d.Get("follow_redirects"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("follow_redirects") {
+	} else if hasChange(d, "follow_redirects") {
 		body["follow_redirects"] = followRedirects
 	}
 
 	monitoredResource, err := expandSSLCheckMonitoredResource(d.Get("monitored_resource"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("monitored_resource") {
+	} else if hasChange(d, "monitored_resource") {
 		body = merge(body, monitoredResource.(map[string]interface{}))
 	}
 
 	paused, err := expandSSLCheckPaused(d.Get("paused"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("paused") {
+	} else if hasChange(d, "paused", "manage_paused") && d.Get("manage_paused").(bool) {
 		body["paused"] = paused
 	}
 
 	userAgent, err := expandSSLCheckUserAgent(d.Get("user_agent"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("user_agent") {
+	} else if hasChange(d, "user_agent") {
 		body["user_agent"] = userAgent
 	}
 
-	log.Print("[DEBUG] Creating StatusCake SSL check")
-	log.Printf("[DEBUG] Request body: %+v", body)
+	find := func() (string, error) {
+		return findSSLCheck(ctx, client, d.Get("monitored_resource.0.address").(string))
+	}
 
-	res, err := client.CreateSslTestWithData(ctx, body).Execute()
-	if err != nil {
-		return intdiag.FromErr("failed to create SSL check", err)
+	create := func() (string, error) {
+		res, err := client.CreateSslTestWithData(ctx, body).Execute()
+		return res.Data.NewID, err
+	}
+
+	update := func(id string) error {
+		return client.UpdateSslTestWithData(ctx, id, body).Execute()
 	}
 
-	d.SetId(res.Data.NewID)
+	if diags := createOrAdopt(d, "SSL check", body, find, create, update); diags.HasError() {
+		return diags
+	}
 	return resourceStatusCakeSSLCheckRead(ctx, d, meta)
 }
 
 func resourceStatusCakeSSLCheckRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*statuscake.Client)
+	client := meta.(*providerConfig).client
 	id := d.Id()
 
-	res, err := client.GetSslTest(ctx, id).Execute()
+	res, err := retryNotFound(ctx, d, client.GetSslTest(ctx, id).Execute)
 
-	// If the resource is not found then remove it from the state.
-	if err, ok := err.(statuscake.APIError); ok && err.Status == http.StatusNotFound {
+	// If the resource is not found then remove it from the state. A new resource
+	// that cannot be found is an error.
+	if isNotFound(err) && !d.IsNewResource() {
 		d.SetId("")
 		return nil
 	}
@@ -232,8 +257,17 @@ func resourceStatusCakeSSLCheckRead(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("failed to read monitored resource: %s", err)
 	}
 
-	if err := d.Set("paused", flattenSSLCheckPaused(res.Data.Paused, d)); err != nil {
-		return diag.Errorf("failed to read paused: %s", err)
+	// The paused state is retained when it is not managed by Terraform so that
+	// changes made outside of Terraform are not reported as drift.
+	managed := managePaused(d, meta)
+	if err := d.Set("manage_paused", managed); err != nil {
+		return diag.Errorf("failed to read manage paused: %s", err)
+	}
+
+	if managed {
+		if err := d.Set("paused", flattenSSLCheckPaused(res.Data.Paused, d)); err != nil {
+			return diag.Errorf("failed to read paused: %s", err)
+		}
 	}
 
 	if err := d.Set("user_agent", flattenSSLCheckUserAgent(res.Data.UserAgent, d)); err != nil {
@@ -244,7 +278,7 @@ func resourceStatusCakeSSLCheckRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceStatusCakeSSLCheckUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*statuscake.Client)
+	client := meta.(*providerConfig).client
 	body := make(map[string]interface{})
 	id := d.Id()
 
@@ -286,7 +320,7 @@ func resourceStatusCakeSSLCheckUpdate(ctx context.Context, d *schema.ResourceDat
 	paused, err := expandSSLCheckPaused(d.Get("paused"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("paused") {
+	} else if d.HasChanges("paused", "manage_paused") && d.Get("manage_paused").(bool) {
 		body["paused"] = paused
 	}
 
@@ -297,6 +331,13 @@ func resourceStatusCakeSSLCheckUpdate(ctx context.Context, d *schema.ResourceDat
 		body["user_agent"] = userAgent
 	}
 
+	// Attributes such as deletion_protection and manage_paused only change the
+	// behaviour of the provider, so changing them alone does not update the
+	// check.
+	if len(body) == 0 {
+		return resourceStatusCakeSSLCheckRead(ctx, d, meta)
+	}
+
 	log.Printf("[DEBUG] Updating StatusCake SSL check with ID: %s", id)
 	log.Printf("[DEBUG] Request body: %+v", body)
 
@@ -308,12 +349,27 @@ func resourceStatusCakeSSLCheckUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceStatusCakeSSLCheckDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*statuscake.Client)
+	client := meta.(*providerConfig).client
 	id := d.Id()
 
+	pause, diags := checkDeletion(d, "SSL check")
+	if diags.HasError() {
+		return diags
+	}
+
+	if pause {
+		log.Printf("[DEBUG] Pausing StatusCake SSL check with ID: %s instead of deleting it", id)
+
+		if err := client.UpdateSslTest(ctx, id).Paused(true).Execute(); err != nil && !isNotFound(err) {
+			return intdiag.FromErr(fmt.Sprintf("failed to pause SSL check with id %s", id), err)
+		}
+
+		return nil
+	}
+
 	log.Printf("[DEBUG] Deleting StatusCake SSL check with ID: %s", id)
 
-	if err := client.DeleteSslTest(ctx, id).Execute(); err != nil {
+	if err := client.DeleteSslTest(ctx, id).Execute(); err != nil && !isNotFound(err) {
 		return intdiag.FromErr(fmt.Sprintf("failed to delete SSL check with id %s", id), err)
 	}
 
@@ -349,35 +405,35 @@ func expandSSLCheckAlertConfig(v interface{}, d *schema.ResourceData) (interface
 	alertAt, err := expandSSLCheckAlertAt(original["alert_at"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("alert_config.0.alert_at") {
+	} else if hasChange(d, "alert_config.0.alert_at") {
 		transformed["alert_at"] = alertAt
 	}
 
 	broken, err := expandSSLCheckOnBroken(original["on_broken"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("alert_config.0.on_broken") {
+	} else if hasChange(d, "alert_config.0.on_broken") {
 		transformed["alert_broken"] = broken
 	}
 
 	expiry, err := expandSSLCheckOnExpiry(original["on_expiry"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("alert_config.0.on_expiry") {
+	} else if hasChange(d, "alert_config.0.on_expiry") {
 		transformed["alert_expiry"] = expiry
 	}
 
 	mixed, err := expandSSLCheckOnMixed(original["on_mixed"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("alert_config.0.on_mixed") {
+	} else if hasChange(d, "alert_config.0.on_mixed") {
 		transformed["alert_mixed"] = mixed
 	}
 
 	reminder, err := expandSSLCheckOnReminder(original["on_reminder"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("alert_config.0.on_reminder") {
+	} else if hasChange(d, "alert_config.0.on_reminder") {
 		transformed["alert_reminder"] = reminder
 	}
 
@@ -398,11 +454,15 @@ func flattenSSLCheckAlertConfig(v interface{}, d *schema.ResourceData) interface
 }
 
 func expandSSLCheckInterval(v interface{}, d *schema.ResourceData) (interface{}, error) {
-	return statuscake.SSLTestCheckRate(v.(int)), nil
+	n, err := intvalidation.ParseDuration(v.(string), time.Second)
+	if err != nil {
+		return nil, err
+	}
+	return statuscake.SSLTestCheckRate(n), nil
 }
 
 func flattenSSLCheckInterval(v interface{}, d *schema.ResourceData) interface{} {
-	return int(v.(statuscake.SSLTestCheckRate))
+	return strconv.Itoa(int(v.(statuscake.SSLTestCheckRate)))
 }
 
 func expandSSLCheckContactGroups(v interface{}, d *schema.ResourceData) (interface{}, error) {
@@ -442,14 +502,14 @@ func expandSSLCheckMonitoredResource(v interface{}, d *schema.ResourceData) (int
 	address, err := expandSSLCheckAddress(original["address"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("monitored_resource.0.address") {
+	} else if hasChange(d, "monitored_resource.0.address") {
 		transformed["website_url"] = address
 	}
 
 	hostname, err := expandSSLCheckHostname(original["hostname"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("monitored_resource.0.hostname") {
+	} else if hasChange(d, "monitored_resource.0.hostname") {
 		transformed["hostname"] = hostname
 	}
 
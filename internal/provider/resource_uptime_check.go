@@ -2,15 +2,26 @@ package provider
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
-	"net/http"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/StatusCakeDev/statuscake-go"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	intdiag "github.com/StatusCakeDev/terraform-provider-statuscake/v2/internal/provider/diag"
@@ -34,23 +45,36 @@ func isTCPCheckType(t statuscake.UptimeTestType) bool {
 }
 
 func resourceStatusCakeUptimeCheck() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		CreateContext: resourceStatusCakeUptimeCheckCreate,
 		ReadContext:   resourceStatusCakeUptimeCheckRead,
 		UpdateContext: resourceStatusCakeUptimeCheckUpdate,
 		DeleteContext: resourceStatusCakeUptimeCheckDelete,
 
 		// Used by `terraform import`.
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer: importer("statuscake_uptime_check"),
+
+		// Used to reject combinations of attributes that are individually valid
+		// but which are not accepted by the API.
+		CustomizeDiff: customdiff.All(
+			resourceStatusCakeUptimeCheckFinalEndpointDiff,
+			resourceStatusCakeUptimeCheckRequestMethodDiff,
+			resourceStatusCakeUptimeCheckTCPAuthenticationDiff,
+			resourceStatusCakeUptimeCheckConfirmationDiff,
+			resourceStatusCakeUptimeCheckRegionsDiff,
+			resourceStatusCakeUptimeCheckSensitiveRequestHeadersDiff,
+			contactGroupsDiff,
+			managePausedDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
+			"adopt_existing": adoptExistingSchema("name and check type"),
 			"check_interval": {
-				Type:         schema.TypeInt,
+				Type:         schema.TypeString,
 				Required:     true,
-				Description:  "Number of seconds between checks",
-				ValidateFunc: intvalidation.Int32InSlice(statuscake.UptimeTestCheckRateValues()),
+				Description:  "Number of seconds between checks. May also be given as a duration such as `5m`",
+				StateFunc:    normalizeDuration(time.Second),
+				ValidateFunc: intvalidation.DurationInSlice(statuscake.UptimeTestCheckRateValues(), time.Second),
 			},
 			"confirmation": {
 				Type:         schema.TypeInt,
@@ -68,6 +92,7 @@ func resourceStatusCakeUptimeCheck() *schema.Resource {
 					ValidateFunc: intvalidation.StringIsNumerical,
 				},
 			},
+			"deletion_protection": deletionProtectionSchema(),
 			"dns_check": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -87,10 +112,12 @@ func resourceStatusCakeUptimeCheck() *schema.Resource {
 							},
 						},
 						"dns_server": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Description:  "FQDN or IP address of the nameserver to query",
-							ValidateFunc: validation.StringIsNotEmpty,
+							Type:                  schema.TypeString,
+							Optional:              true,
+							Description:           "FQDN or IP address of the nameserver to query",
+							ValidateFunc:          validation.StringIsNotEmpty,
+							DiffSuppressFunc:      suppressEquivalentAddress,
+							DiffSuppressOnRefresh: true,
 						},
 					},
 				},
@@ -110,7 +137,7 @@ func resourceStatusCakeUptimeCheck() *schema.Resource {
 							MaxItems:    1,
 							Description: "Basic Authentication (RFC7235) configuration block",
 							Elem: &schema.Resource{
-								Schema: basicAuthSchema(),
+								Schema: basicAuthSchema("http_check.0.basic_authentication.0"),
 							},
 						},
 						"content_matchers": {
@@ -168,6 +195,15 @@ func resourceStatusCakeUptimeCheck() *schema.Resource {
 								Type: schema.TypeString,
 							},
 						},
+						"sensitive_request_headers": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "Represents headers to be sent when making requests whose values should not be displayed, such as `Authorization`. These are sent alongside `request_headers`",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
 						"request_method": {
 							Type:         schema.TypeString,
 							Optional:     true,
@@ -179,36 +215,50 @@ func resourceStatusCakeUptimeCheck() *schema.Resource {
 						"request_payload": {
 							Type:        schema.TypeMap,
 							Optional:    true,
-							Description: "Payload submitted with the request. Setting this updates the check to use the HTTP POST verb. Only one of `request_payload` or `request_payload_raw` may be specified",
+							Description: "Payload submitted with the request. Setting this updates the check to use the HTTP POST verb. Only one of `request_payload`, `request_payload_json`, or `request_payload_raw` may be specified",
 							Elem: &schema.Schema{
 								Type: schema.TypeString,
 							},
-							ConflictsWith: []string{"http_check.0.request_payload_raw"},
+							ConflictsWith: []string{"http_check.0.request_payload_json", "http_check.0.request_payload_raw"},
+						},
+						"request_payload_json": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Description:      "JSON encoded payload submitted with the request, such as the result of `jsonencode`. Unlike `request_payload` this supports nested values. Setting this updates the check to use the HTTP POST verb. Only one of `request_payload`, `request_payload_json`, or `request_payload_raw` may be specified",
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: structure.SuppressJsonDiff,
+							ConflictsWith:    []string{"http_check.0.request_payload", "http_check.0.request_payload_raw"},
 						},
 						"request_payload_raw": {
-							Type:          schema.TypeString,
-							Optional:      true,
-							Description:   "Raw payload submitted with the request. Setting this updates the check to use the HTTP POST verb. Only one of `request_payload` or `request_payload_raw` may be specified",
-							ValidateFunc:  validation.StringIsNotEmpty,
-							ConflictsWith: []string{"http_check.0.request_payload"},
+							Type:             schema.TypeString,
+							Optional:         true,
+							Description:      "Raw payload submitted with the request. Setting this updates the check to use the HTTP POST verb. Only one of `request_payload`, `request_payload_json`, or `request_payload_raw` may be specified",
+							ValidateFunc:     validation.StringIsNotEmpty,
+							DiffSuppressFunc: structure.SuppressJsonDiff,
+							ConflictsWith:    []string{"http_check.0.request_payload", "http_check.0.request_payload_json"},
 						},
 						"status_codes": {
 							Type:        schema.TypeSet,
 							Computed:    true,
 							Optional:    true,
 							MinItems:    1,
-							Description: "List of status codes that trigger an alert. If not specified then the default status codes are used. Once set, the default status codes cannot be restored and ommitting this field does not clear the attribute",
+							Description: "List of status codes that trigger an alert. Each entry may be a single status code (`404`), a class of status codes (`5xx`), or an inclusive range of status codes (`400-404`). If not specified then the default status codes are used. Once set, the default status codes cannot be restored and ommitting this field does not clear the attribute",
 							Elem: &schema.Schema{
 								Type:         schema.TypeString,
-								ValidateFunc: intvalidation.StringIsNumerical,
+								StateFunc:    normalizeStatusCode,
+								ValidateFunc: intvalidation.IsStatusCodes,
+							},
+							Set: func(v interface{}) int {
+								return schema.HashString(normalizeStatusCode(v))
 							},
 						},
 						"timeout": {
-							Type:         schema.TypeInt,
+							Type:         schema.TypeString,
 							Optional:     true,
-							Default:      15,
-							Description:  "The number of seconds to wait to receive the first byte",
-							ValidateFunc: validation.IntBetween(5, 75),
+							Default:      "15",
+							Description:  "The number of seconds to wait to receive the first byte. May also be given as a duration such as `30s`",
+							StateFunc:    normalizeDuration(time.Second),
+							ValidateFunc: intvalidation.DurationBetween(5, 75, time.Second),
 						},
 						"user_agent": {
 							Type:         schema.TypeString,
@@ -239,8 +289,13 @@ func resourceStatusCakeUptimeCheck() *schema.Resource {
 						"enabled": {
 							Type:        schema.TypeBool,
 							Optional:    true,
-							Default:     true,
-							Description: "Dummy attribute to allow for a nested block. This field should not be changed",
+							Deprecated:  "This attribute has no effect and will be removed in a future version. Use an empty `icmp_check {}` block instead",
+							Description: "Has no effect. The attribute is retained only so that existing configuration remains valid",
+							// The attribute is never stored in the state so any configured
+							// value is ignored.
+							DiffSuppressFunc: func(_, _, _ string, _ *schema.ResourceData) bool {
+								return true
+							},
 						},
 					},
 				},
@@ -262,10 +317,12 @@ func resourceStatusCakeUptimeCheck() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"address": {
-							Type:         schema.TypeString,
-							Required:     true,
-							Description:  "URL, FQDN, or IP address of the server under test",
-							ValidateFunc: validation.StringIsNotEmpty,
+							Type:                  schema.TypeString,
+							Required:              true,
+							Description:           "URL, FQDN, or IP address of the server under test",
+							ValidateFunc:          validation.StringIsNotEmpty,
+							DiffSuppressFunc:      suppressEquivalentAddress,
+							DiffSuppressOnRefresh: true,
 						},
 						"host": {
 							Type:         schema.TypeString,
@@ -282,11 +339,13 @@ func resourceStatusCakeUptimeCheck() *schema.Resource {
 				Description:  "Name of the check",
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
+			"manage_paused": managePausedSchema(),
+			"on_destroy":    onDestroySchema(),
 			"paused": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     false,
-				Description: "Whether the check should be run",
+				Description: "Whether the check should be run. Ignored when `manage_paused` is disabled",
 			},
 			"regions": {
 				Type:        schema.TypeList,
@@ -297,6 +356,12 @@ func resourceStatusCakeUptimeCheck() *schema.Resource {
 					ValidateFunc: validation.StringIsNotEmpty,
 				},
 			},
+			"sensitive_request_headers_hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Salted hash of the sensitive request headers last read from the API. This is used to detect drift without storing the header values returned by the API",
+			},
 			"tags": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -320,7 +385,7 @@ func resourceStatusCakeUptimeCheck() *schema.Resource {
 							MaxItems:    1,
 							Description: "Authentication configuration block",
 							Elem: &schema.Resource{
-								Schema: basicAuthSchema(),
+								Schema: basicAuthSchema("tcp_check.0.authentication.0"),
 							},
 						},
 						"port": {
@@ -338,52 +403,261 @@ func resourceStatusCakeUptimeCheck() *schema.Resource {
 							ValidateFunc: validation.StringInSlice([]string{"SMTP", "SSH", "TCP"}, false),
 						},
 						"timeout": {
-							Type:         schema.TypeInt,
+							Type:         schema.TypeString,
 							Optional:     true,
-							Default:      15,
-							Description:  "The number of seconds to wait to receive the first byte",
-							ValidateFunc: validation.IntBetween(5, 75),
+							Default:      "15",
+							Description:  "The number of seconds to wait to receive the first byte. May also be given as a duration such as `30s`",
+							StateFunc:    normalizeDuration(time.Second),
+							ValidateFunc: intvalidation.DurationBetween(5, 75, time.Second),
 						},
 					},
 				},
 				ExactlyOneOf: []string{"dns_check", "http_check", "icmp_check", "tcp_check"},
 			},
 			"trigger_rate": {
-				Type:         schema.TypeInt,
+				Type:         schema.TypeString,
 				Optional:     true,
-				Default:      0,
-				Description:  "The number of minutes to wait before sending an alert",
-				ValidateFunc: validation.IntBetween(0, 60),
+				Default:      "0",
+				Description:  "The number of minutes to wait before sending an alert. May also be given as a duration such as `1h`",
+				StateFunc:    normalizeDuration(time.Minute),
+				ValidateFunc: intvalidation.DurationBetween(0, 60, time.Minute),
+			},
+			"wait_for_healthy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Wait for the check to report the server under test as up after it is created or updated. The apply fails if the check does not become healthy in time",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"min_successes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							Description:  "Number of successful results required before the check is considered healthy. HTTP check results are successful when their status code does not trigger an alert. Since other checks record no status code every result is counted",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"timeout": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "300",
+							Description:  "The number of seconds to wait for the check to become healthy. May also be given as a duration such as `10m`",
+							StateFunc:    normalizeDuration(time.Second),
+							ValidateFunc: intvalidation.DurationBetween(1, 1200, time.Second),
+						},
+					},
+				},
 			},
 		},
 	}
+
+	// Used to upgrade the state stored by prior versions of the schema.
+	return withStateUpgrades(r,
+		upgradeUptimeCheckStateV0,
+		upgradeUptimeCheckStateV1,
+	)
 }
 
 // basicAuthSchema returns the schema describing a basic authentication. Since
 // basic auth can be found in multiple check types its structure has been
-// encapsulated within a function.
-func basicAuthSchema() map[string]*schema.Schema {
+// encapsulated within a function. The path is the address of the block within
+// the check and is used to reference sibling attributes.
+func basicAuthSchema(path string) map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"username": {
 			Type:     schema.TypeString,
 			Required: true,
 		},
 		"password": {
-			Type:      schema.TypeString,
-			Required:  true,
-			Sensitive: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ExactlyOneOf: []string{path + ".password", path + ".password_wo"},
+		},
+		"password_wo": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+			Description: "Write-only password. Unlike `password` this value is never stored in the Terraform state. Requires Terraform 1.11 or later",
+		},
+		"password_wo_version": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  "Version of the write-only password. Since `password_wo` is not stored in the Terraform state changes to it cannot be detected. Changing this value causes the password to be sent to StatusCake",
+			RequiredWith: []string{path + ".password_wo"},
 		},
 	}
 }
 
+// resourceStatusCakeUptimeCheckFinalEndpointDiff ensures a final endpoint is
+// only specified when the check is configured to follow redirects. Otherwise
+// the redirect chain is never followed and the value is silently ignored.
+func resourceStatusCakeUptimeCheckFinalEndpointDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("http_check.0.final_endpoint") || !d.NewValueKnown("http_check.0.follow_redirects") {
+		return nil
+	}
+
+	if d.Get("http_check.0.final_endpoint").(string) != "" && !d.Get("http_check.0.follow_redirects").(bool) {
+		return fmt.Errorf("http_check.0.final_endpoint: requires http_check.0.follow_redirects to be enabled")
+	}
+
+	return nil
+}
+
+// resourceStatusCakeUptimeCheckRequestMethodDiff ensures that HEAD requests
+// are not configured with a request body or content matchers. HEAD requests
+// neither send nor receive a body.
+func resourceStatusCakeUptimeCheckRequestMethodDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("http_check.0.request_method") || d.Get("http_check.0.request_method").(string) != string(statuscake.UptimeTestTypeHEAD) {
+		return nil
+	}
+
+	var errs []error
+	if len(d.Get("http_check.0.request_payload").(map[string]interface{})) != 0 {
+		errs = append(errs, fmt.Errorf("http_check.0.request_payload: cannot be set when http_check.0.request_method is HEAD"))
+	}
+
+	if d.Get("http_check.0.request_payload_json").(string) != "" {
+		errs = append(errs, fmt.Errorf("http_check.0.request_payload_json: cannot be set when http_check.0.request_method is HEAD"))
+	}
+
+	if d.Get("http_check.0.request_payload_raw").(string) != "" {
+		errs = append(errs, fmt.Errorf("http_check.0.request_payload_raw: cannot be set when http_check.0.request_method is HEAD"))
+	}
+
+	if len(d.Get("http_check.0.content_matchers").([]interface{})) != 0 {
+		errs = append(errs, fmt.Errorf("http_check.0.content_matchers: cannot be set when http_check.0.request_method is HEAD"))
+	}
+
+	return errors.Join(errs...)
+}
+
+// resourceStatusCakeUptimeCheckTCPAuthenticationDiff ensures authentication is
+// only specified for TCP checks using a protocol that supports it.
+func resourceStatusCakeUptimeCheckTCPAuthenticationDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("tcp_check.0.protocol") || d.Get("tcp_check.0.protocol").(string) != string(statuscake.UptimeTestTypeTCP) {
+		return nil
+	}
+
+	if len(d.Get("tcp_check.0.authentication").([]interface{})) != 0 {
+		return fmt.Errorf("tcp_check.0.authentication: cannot be set when tcp_check.0.protocol is TCP")
+	}
+
+	return nil
+}
+
+// resourceStatusCakeUptimeCheckConfirmationDiff ensures the number of
+// confirmation servers does not exceed the number of regions from which the
+// check is run.
+func resourceStatusCakeUptimeCheckConfirmationDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("confirmation") || !d.NewValueKnown("regions") {
+		return nil
+	}
+
+	regions := d.Get("regions").([]interface{})
+	if len(regions) == 0 {
+		return nil
+	}
+
+	if confirmation := d.Get("confirmation").(int); confirmation > len(regions) {
+		return fmt.Errorf("confirmation: cannot exceed the number of regions (%d), got %d", len(regions), confirmation)
+	}
+
+	return nil
+}
+
+// resourceStatusCakeUptimeCheckRegionsDiff ensures each region code resolves
+// to a known uptime monitoring location. Locations are only listed when the
+// regions have changed.
+func resourceStatusCakeUptimeCheckRegionsDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("regions") || !d.NewValueKnown("regions") {
+		return nil
+	}
+
+	codes := d.Get("regions").([]interface{})
+	if len(codes) == 0 {
+		return nil
+	}
+
+	client := meta.(*providerConfig).client
+
+	regions, err := monitoringLocationsByRegion(ctx, client, listUptimeMonitoringLocations)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for i, code := range codes {
+		if err := validateRegionCode(regions, fmt.Sprintf("regions.%d", i), code.(string)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// resourceStatusCakeUptimeCheckSensitiveRequestHeadersDiff compares the hash of
+// the configured sensitive request headers with the hash of those last read
+// from the API. A difference indicates drift and causes the headers to be
+// resubmitted without their values being displayed.
+func resourceStatusCakeUptimeCheckSensitiveRequestHeadersDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("http_check.0.sensitive_request_headers") {
+		return d.SetNewComputed("sensitive_request_headers_hash")
+	}
+
+	sensitive := d.Get("http_check.0.sensitive_request_headers").(map[string]interface{})
+	headers := d.Get("http_check.0.request_headers").(map[string]interface{})
+
+	var errs []error
+	for k := range sensitive {
+		if _, ok := headers[k]; ok {
+			errs = append(errs, fmt.Errorf("http_check.0.sensitive_request_headers: header %q is also specified in http_check.0.request_headers", k))
+		}
+	}
+
+	if len(errs) != 0 {
+		return errors.Join(errs...)
+	}
+
+	old := d.Get("sensitive_request_headers_hash").(string)
+	if len(sensitive) == 0 {
+		if old != "" {
+			return d.SetNew("sensitive_request_headers_hash", "")
+		}
+		return nil
+	}
+
+	// Without the salt of a previous hash the hash cannot be computed until
+	// apply, since a random salt would differ between plan and apply.
+	salt, ok := uptimeCheckSensitiveRequestHeadersSalt(old)
+	if !ok {
+		return d.SetNewComputed("sensitive_request_headers_hash")
+	}
+
+	hash, err := hashUptimeCheckSensitiveRequestHeaders(sensitive, salt)
+	if err != nil {
+		return err
+	}
+
+	if old != hash {
+		return d.SetNew("sensitive_request_headers_hash", hash)
+	}
+
+	return nil
+}
+
 func resourceStatusCakeUptimeCheckCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*statuscake.Client)
+	client := meta.(*providerConfig).client
 	body := make(map[string]interface{})
 
+	// The tags of uptime checks determine the checks covered by maintenance
+	// windows, so the cached listing of uptime checks is discarded.
+	defer meta.(*providerConfig).uptimeChecks.Invalidate()
+
 	checkInterval, err := expandUptimeCheckInterval(d.Get("check_interval"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("check_interval") {
+	} else if hasChange(d, "check_interval") {
 		body["check_rate"] = checkInterval
 	}
 
@@ -396,70 +670,70 @@ func resourceStatusCakeUptimeCheckCreate(ctx context.Context, d *schema.Resource
 	contactGroups, err := expandUptimeCheckContactGroups(d.Get("contact_groups"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("contact_groups") {
+	} else if hasChange(d, "contact_groups") {
 		body["contact_groups"] = contactGroups
 	}
 
 	dnsCheck, err := expandUptimeCheckDNSCheck(d.Get("dns_check"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("dns_check") {
+	} else if hasChange(d, "dns_check") {
 		body = merge(body, dnsCheck.(map[string]interface{}))
 	}
 
 	httpCheck, err := expandUptimeCheckHTTPCheck(d.Get("http_check"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("http_check") {
+	} else if hasChange(d, "http_check", "sensitive_request_headers_hash") {
 		body = merge(body, httpCheck.(map[string]interface{}))
 	}
 
 	icmpCheck, err := expandUptimeCheckICMPCheck(d.Get("icmp_check"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("icmp_check") {
+	} else if hasChange(d, "icmp_check") {
 		body = merge(body, icmpCheck.(map[string]interface{}))
 	}
 
 	monitoredResource, err := expandUptimeCheckMonitoredResource(d.Get("monitored_resource"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("monitored_resource") {
+	} else if hasChange(d, "monitored_resource") {
 		body = merge(body, monitoredResource.(map[string]interface{}))
 	}
 
 	name, err := expandUptimeCheckName(d.Get("name"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("name") {
+	} else if hasChange(d, "name") {
 		body["name"] = name
 	}
 
 	paused, err := expandUptimeCheckPaused(d.Get("paused"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("paused") {
+	} else if hasChange(d, "paused", "manage_paused") && d.Get("manage_paused").(bool) {
 		body["paused"] = paused
 	}
 
 	regions, err := expandUptimeCheckRegions(d.Get("regions"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("regions") {
+	} else if hasChange(d, "regions") {
 		body["regions"] = regions
 	}
 
 	tags, err := expandUptimeCheckTags(d.Get("tags"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("tags") {
+	} else if hasChange(d, "tags") {
 		body["tags"] = tags
 	}
 
 	tcpCheck, err := expandUptimeCheckTCPCheck(d.Get("tcp_check"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("tcp_check") {
+	} else if hasChange(d, "tcp_check") {
 		body = merge(body, tcpCheck.(map[string]interface{}))
 	}
 
@@ -469,26 +743,41 @@ func resourceStatusCakeUptimeCheckCreate(ctx context.Context, d *schema.Resource
 	}
 	body["trigger_rate"] = triggerRate
 
-	log.Print("[DEBUG] Creating StatusCake uptime check")
-	log.Printf("[DEBUG] Request body: %+v", body)
+	since := time.Now()
 
-	res, err := client.CreateUptimeTestWithData(ctx, body).Execute()
-	if err != nil {
-		return intdiag.FromErr("failed to create uptime check", err)
+	find := func() (string, error) {
+		return findUptimeCheck(ctx, client, d.Get("name").(string), fmt.Sprint(body["test_type"]))
+	}
+
+	create := func() (string, error) {
+		res, err := client.CreateUptimeTestWithData(ctx, body).Execute()
+		return res.Data.NewID, err
+	}
+
+	update := func(id string) error {
+		return client.UpdateUptimeTestWithData(ctx, id, body).Execute()
+	}
+
+	if diags := createOrAdopt(d, "uptime check", body, find, create, update); diags.HasError() {
+		return diags
+	}
+
+	if err := waitForUptimeCheckHealthy(ctx, client, d, since); err != nil {
+		return diag.FromErr(err)
 	}
 
-	d.SetId(res.Data.NewID)
 	return resourceStatusCakeUptimeCheckRead(ctx, d, meta)
 }
 
 func resourceStatusCakeUptimeCheckRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*statuscake.Client)
+	client := meta.(*providerConfig).client
 	id := d.Id()
 
-	res, err := client.GetUptimeTest(ctx, id).Execute()
+	res, err := retryNotFound(ctx, d, client.GetUptimeTest(ctx, id).Execute)
 
-	// If the resource is not found then remove it from the state.
-	if err, ok := err.(statuscake.APIError); ok && err.Status == http.StatusNotFound {
+	// If the resource is not found then remove it from the state. A new resource
+	// that cannot be found is an error.
+	if isNotFound(err) && !d.IsNewResource() {
 		d.SetId("")
 		return nil
 	}
@@ -516,6 +805,19 @@ func resourceStatusCakeUptimeCheckRead(ctx context.Context, d *schema.ResourceDa
 		return diag.Errorf("failed to read HTTP check: %s", err)
 	}
 
+	if err := d.Set("sensitive_request_headers_hash", flattenUptimeCheckSensitiveRequestHeadersHash(res.Data.CustomHeader, d)); err != nil {
+		return diag.Errorf("failed to read sensitive request headers hash: %s", err)
+	}
+
+	var diags diag.Diagnostics
+	if _, err := decodeUptimeCheckRequestHeaders(stringElem(res.Data.CustomHeader)); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "failed to read request headers",
+			Detail:   fmt.Sprintf("The request headers of uptime check with ID %s could not be read and will be replaced by the configured headers on the next apply: %s", id, err),
+		})
+	}
+
 	if err := d.Set("icmp_check", flattenUptimeCheckICMPCheck(res.Data, d)); err != nil {
 		return diag.Errorf("failed to read ICMP check: %s", err)
 	}
@@ -528,14 +830,30 @@ func resourceStatusCakeUptimeCheckRead(ctx context.Context, d *schema.ResourceDa
 		return diag.Errorf("failed to read name: %s", err)
 	}
 
-	if err := d.Set("paused", flattenUptimeCheckPaused(res.Data.Paused, d)); err != nil {
-		return diag.Errorf("failed to read paused: %s", err)
+	// The paused state is retained when it is not managed by Terraform so that
+	// changes made outside of Terraform are not reported as drift.
+	managed := managePaused(d, meta)
+	if err := d.Set("manage_paused", managed); err != nil {
+		return diag.Errorf("failed to read manage paused: %s", err)
+	}
+
+	if managed {
+		if err := d.Set("paused", flattenUptimeCheckPaused(res.Data.Paused, d)); err != nil {
+			return diag.Errorf("failed to read paused: %s", err)
+		}
 	}
 
 	if err := d.Set("locations", flattenMonitoringLocations(res.Data.Servers, d)); err != nil {
 		return diag.Errorf("failed to read locations: %s", err)
 	}
 
+	// The status of the configured regions is taken from the monitoring
+	// locations the check is run from to avoid listing every location.
+	regions := groupMonitoringLocationsByRegion(res.Data.Servers)
+	for i, code := range d.Get("regions").([]interface{}) {
+		diags = append(diags, regionCodeDiagnostics(regions, cty.GetAttrPath("regions").IndexInt(i), code.(string))...)
+	}
+
 	if err := d.Set("tags", flattenUptimeCheckTags(res.Data.Tags, d)); err != nil {
 		return diag.Errorf("failed to read tags: %s", err)
 	}
@@ -548,14 +866,16 @@ func resourceStatusCakeUptimeCheckRead(ctx context.Context, d *schema.ResourceDa
 		return diag.Errorf("failed to read trigger rate: %s", err)
 	}
 
-	return nil
+	return diags
 }
 
 func resourceStatusCakeUptimeCheckUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*statuscake.Client)
+	client := meta.(*providerConfig).client
 	body := make(map[string]interface{})
 	id := d.Id()
 
+	defer meta.(*providerConfig).uptimeChecks.Invalidate()
+
 	checkInterval, err := expandUptimeCheckInterval(d.Get("check_interval"), d)
 	if err != nil {
 		return diag.FromErr(err)
@@ -587,7 +907,7 @@ func resourceStatusCakeUptimeCheckUpdate(ctx context.Context, d *schema.Resource
 	httpCheck, err := expandUptimeCheckHTTPCheck(d.Get("http_check"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("http_check") {
+	} else if d.HasChanges("http_check", "sensitive_request_headers_hash") {
 		body = merge(body, httpCheck.(map[string]interface{}))
 	}
 
@@ -615,7 +935,7 @@ func resourceStatusCakeUptimeCheckUpdate(ctx context.Context, d *schema.Resource
 	paused, err := expandUptimeCheckPaused(d.Get("paused"), d)
 	if err != nil {
 		return diag.FromErr(err)
-	} else if d.HasChange("paused") {
+	} else if d.HasChanges("paused", "manage_paused") && d.Get("manage_paused").(bool) {
 		body["paused"] = paused
 	}
 
@@ -647,23 +967,151 @@ func resourceStatusCakeUptimeCheckUpdate(ctx context.Context, d *schema.Resource
 		body["trigger_rate"] = triggerRate
 	}
 
+	// Attributes such as wait_for_healthy only change the behaviour of the
+	// provider, so changing them alone neither updates the check nor waits for
+	// it to become healthy.
+	if len(body) == 0 {
+		return resourceStatusCakeUptimeCheckRead(ctx, d, meta)
+	}
+
 	log.Printf("[DEBUG] Updating StatusCake uptime check with ID: %s", id)
 	log.Printf("[DEBUG] Request body: %+v", body)
 
+	since := time.Now()
+
 	if err := client.UpdateUptimeTestWithData(ctx, id, body).Execute(); err != nil {
 		return intdiag.FromErr(fmt.Sprintf("failed to update uptime check with id %s", id), err)
 	}
 
+	if err := waitForUptimeCheckHealthy(ctx, client, d, since); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceStatusCakeUptimeCheckRead(ctx, d, meta)
 }
 
+// uptimeCheckHealthyPollInterval is the interval at which the status of a
+// check is polled while waiting for it to become healthy.
+const uptimeCheckHealthyPollInterval = 10 * time.Second
+
+// waitForUptimeCheckHealthy waits for the check to report the server under
+// test as up when configured to do so. Only results recorded since the given
+// time are considered. Paused checks produce no results and so are not waited
+// for.
+func waitForUptimeCheckHealthy(ctx context.Context, client *statuscake.Client, d *schema.ResourceData, since time.Time) error {
+	l := d.Get("wait_for_healthy").([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	if d.Get("paused").(bool) && d.Get("manage_paused").(bool) {
+		log.Printf("[WARN] Not waiting for paused StatusCake uptime check with ID: %s to become healthy", d.Id())
+		return nil
+	}
+
+	original := l[0].(map[string]interface{})
+	minSuccesses := original["min_successes"].(int)
+
+	timeout, err := intvalidation.ParseDuration(original["timeout"].(string), time.Second)
+	if err != nil {
+		return err
+	}
+
+	id := d.Id()
+	log.Printf("[DEBUG] Waiting for StatusCake uptime check with ID: %s to become healthy", id)
+
+	var last *statuscake.UptimeTestHistoryResult
+	deadline := time.Now().Add(time.Duration(timeout) * time.Second)
+	for {
+		res, err := retryNotFound(ctx, d, client.GetUptimeTest(ctx, id).Execute)
+		if err != nil {
+			return fmt.Errorf("failed to get uptime check with ID: %s, error: %w", id, err)
+		}
+
+		history, err := listUptimeCheckHistory(ctx, client, id, since)
+		if err != nil {
+			return fmt.Errorf("failed to list uptime check history with ID: %s, error: %w", id, err)
+		}
+
+		var successes int
+		for _, result := range history {
+			if last == nil || result.Created.After(last.Created) {
+				last = &result
+			}
+
+			if isUptimeCheckResultSuccessful(res.Data, result) {
+				successes++
+			}
+		}
+
+		if res.Data.Status == statuscake.UptimeTestStatusUp && successes >= minSuccesses {
+			return nil
+		}
+
+		log.Printf("[DEBUG] StatusCake uptime check with ID: %s has %d of %d successful results", id, successes, minSuccesses)
+
+		if time.Now().Add(uptimeCheckHealthyPollInterval).After(deadline) {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(uptimeCheckHealthyPollInterval):
+		}
+	}
+
+	if last == nil {
+		return fmt.Errorf("uptime check with ID: %s did not become healthy within %s: no results were recorded", id, time.Duration(timeout)*time.Second)
+	}
+
+	code, location := "unknown", "unknown"
+	if last.StatusCode != nil {
+		code = strconv.Itoa(int(*last.StatusCode))
+	}
+	if last.Location != nil {
+		location = *last.Location
+	}
+
+	return fmt.Errorf("uptime check with ID: %s did not become healthy within %s: last result had status code %s from location %s", id, time.Duration(timeout)*time.Second, code, location)
+}
+
+// isUptimeCheckResultSuccessful reports whether the result of the check
+// represents a successful check. Only HTTP checks record a status code, which
+// is successful when it does not trigger an alert. Other checks record no
+// status code, so every result is counted and the status of the check
+// determines whether the server under test is up.
+func isUptimeCheckResultSuccessful(check statuscake.UptimeTest, result statuscake.UptimeTestHistoryResult) bool {
+	if !isHTTPCheckType(check.TestType) {
+		return true
+	}
+	return result.StatusCode != nil && *result.StatusCode != 0 && !slices.Contains(check.StatusCodes, strconv.Itoa(int(*result.StatusCode)))
+}
+
 func resourceStatusCakeUptimeCheckDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*statuscake.Client)
+	client := meta.(*providerConfig).client
 	id := d.Id()
 
+	defer meta.(*providerConfig).uptimeChecks.Invalidate()
+
+	pause, diags := checkDeletion(d, "uptime check")
+	if diags.HasError() {
+		return diags
+	}
+
+	if pause {
+		log.Printf("[DEBUG] Pausing StatusCake uptime check with ID: %s instead of deleting it", id)
+
+		if err := client.UpdateUptimeTest(ctx, id).Paused(true).Execute(); err != nil && !isNotFound(err) {
+			return intdiag.FromErr(fmt.Sprintf("failed to pause uptime check with id %s", id), err)
+		}
+
+		return nil
+	}
+
 	log.Printf("[DEBUG] Deleting StatusCake uptime check with ID: %s", id)
 
-	if err := client.DeleteUptimeTest(ctx, id).Execute(); err != nil {
+	if err := client.DeleteUptimeTest(ctx, id).Execute(); err != nil && !isNotFound(err) {
 		return intdiag.FromErr(fmt.Sprintf("failed to delete uptime check with id %s", id), err)
 	}
 
@@ -691,20 +1139,61 @@ func expandUptimeCheckBasicAuthentication(v interface{}, d *schema.ResourceData)
 	password, err := expandUptimeCheckPassword(original["password"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChanges("http_check.0.basic_authentication.0.password", "tcp_check.0.authentication.0.password") {
+	} else if hasChange(d, "http_check.0.basic_authentication.0.password", "tcp_check.0.authentication.0.password") {
 		transformed["basic_password"] = password
 	}
 
+	// The write-only password is absent from the plan and state and so must be
+	// read from the configuration. It is sent when the check is created, when
+	// switching from a stored password, or when its version changes.
+	passwordWO, err := expandUptimeCheckPasswordWO(d)
+	if err != nil {
+		return nil, err
+	} else if passwordWO != "" && (d.IsNewResource() || d.HasChanges(
+		"http_check.0.basic_authentication.0.password",
+		"http_check.0.basic_authentication.0.password_wo_version",
+		"tcp_check.0.authentication.0.password",
+		"tcp_check.0.authentication.0.password_wo_version",
+	)) {
+		transformed["basic_password"] = passwordWO
+	}
+
 	username, err := expandUptimeCheckUsername(original["username"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChanges("http_check.0.basic_authentication.0.username", "tcp_check.0.authentication.0.username") {
+	} else if hasChange(d, "http_check.0.basic_authentication.0.username", "tcp_check.0.authentication.0.username") {
 		transformed["basic_username"] = username
 	}
 
 	return transformed, nil
 }
 
+// expandUptimeCheckPasswordWO returns the write-only password of whichever
+// authentication block is configured, or an empty string if there is none.
+func expandUptimeCheckPasswordWO(d *schema.ResourceData) (string, error) {
+	paths := []cty.Path{
+		cty.GetAttrPath("http_check").IndexInt(0).GetAttr("basic_authentication").IndexInt(0).GetAttr("password_wo"),
+		cty.GetAttrPath("tcp_check").IndexInt(0).GetAttr("authentication").IndexInt(0).GetAttr("password_wo"),
+	}
+
+	for _, path := range paths {
+		// An error is returned when the path does not exist within the
+		// configuration, such as when the enclosing block is not specified.
+		v, diags := d.GetRawConfigAt(path)
+		if diags.HasError() || v.IsNull() || !v.IsKnown() {
+			continue
+		}
+
+		if !v.Type().Equals(cty.String) {
+			return "", fmt.Errorf("unexpected type %s for write-only password", v.Type().FriendlyName())
+		}
+
+		return v.AsString(), nil
+	}
+
+	return "", nil
+}
+
 func flattenUptimeCheckBasicAuthentication(v interface{}, d *schema.ResourceData) interface{} {
 	return v
 }
@@ -738,21 +1227,21 @@ func expandUptimeCheckContentMatchers(v interface{}, d *schema.ResourceData) (in
 	content, err := expandUptimeCheckContent(original["content"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("http_check.0.content_matchers.0.content") {
+	} else if hasChange(d, "http_check.0.content_matchers.0.content") {
 		transformed["find_string"] = content
 	}
 
 	includeHeaders, err := expandUptimeCheckIncludeHeaders(original["include_headers"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("http_check.0.content_matchers.0.include_headers") {
+	} else if hasChange(d, "http_check.0.content_matchers.0.include_headers") {
 		transformed["include_header"] = includeHeaders
 	}
 
 	invert, err := expandUptimeCheckMatcher(original["matcher"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("http_check.0.content_matchers.0.matcher") {
+	} else if hasChange(d, "http_check.0.content_matchers.0.matcher") {
 		transformed["do_not_find"] = invert
 	}
 
@@ -797,14 +1286,14 @@ func expandUptimeCheckDNSCheck(v interface{}, d *schema.ResourceData) (interface
 	ips, err := expandUptimeCheckDNSIPs(original["dns_ips"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("dns_check.0.dns_ips") {
+	} else if hasChange(d, "dns_check.0.dns_ips") {
 		transformed["dns_ips"] = ips
 	}
 
 	server, err := expandUptimeCheckDNSServer(original["dns_server"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("dns_check.0.dns_server") {
+	} else if hasChange(d, "dns_check.0.dns_server") {
 		transformed["dns_server"] = server
 	}
 
@@ -830,7 +1319,9 @@ func expandUptimeCheckDNSIPs(v interface{}, d *schema.ResourceData) (interface{}
 }
 
 func flattenUptimeCheckDNSIPs(v interface{}, d *schema.ResourceData) interface{} {
-	return v
+	// The API may return IP addresses in a different form to that configured,
+	// such as compressed IPv6 addresses.
+	return flattenAddresses(d.Get("dns_check.0.dns_ips").(*schema.Set), v.([]string))
 }
 
 func expandUptimeCheckDNSServer(v interface{}, d *schema.ResourceData) (interface{}, error) {
@@ -878,91 +1369,106 @@ func expandUptimeCheckHTTPCheck(v interface{}, d *schema.ResourceData) (interfac
 	auth, err := expandUptimeCheckBasicAuthentication(original["basic_authentication"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("http_check.0.basic_authentication") {
+	} else if hasChange(d, "http_check.0.basic_authentication") {
 		transformed = merge(transformed, auth.(map[string]interface{}))
 	}
 
 	matchers, err := expandUptimeCheckContentMatchers(original["content_matchers"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("http_check.0.content_matchers") {
+	} else if hasChange(d, "http_check.0.content_matchers") {
 		transformed = merge(transformed, matchers.(map[string]interface{}))
 	}
 
 	enableCookies, err := expandUptimeCheckEnableCookies(original["enable_cookies"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("http_check.0.enable_cookies") {
+	} else if hasChange(d, "http_check.0.enable_cookies") {
 		transformed["use_jar"] = enableCookies
 	}
 
 	finalEndpoint, err := expandUptimeCheckFinalEndpoint(original["final_endpoint"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("http_check.0.final_endpoint") {
+	} else if hasChange(d, "http_check.0.final_endpoint") {
 		transformed["final_endpoint"] = finalEndpoint
 	}
 
 	followRedirects, err := expandUptimeCheckFollowRedirects(original["follow_redirects"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("http_check.0.follow_redirects") {
+	} else if hasChange(d, "http_check.0.follow_redirects") {
 		transformed["follow_redirects"] = followRedirects
 	}
 
-	headers, err := expandUptimeCheckRequestHeaders(original["request_headers"], d)
+	// Sensitive and non-sensitive request headers are submitted together as
+	// custom headers.
+	headers, err := expandUptimeCheckRequestHeaders(merge(
+		original["sensitive_request_headers"].(map[string]interface{}),
+		original["request_headers"].(map[string]interface{}),
+	), d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("http_check.0.request_headers") {
+	} else if hasChange(d, "http_check.0.request_headers", "http_check.0.sensitive_request_headers", "sensitive_request_headers_hash") {
 		transformed["custom_header"] = headers
 	}
 
 	method, err := expandUptimeCheckRequestMethod(original["request_method"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("http_check.0.request_method") {
+	} else if hasChange(d, "http_check.0.request_method") {
 		transformed["test_type"] = method
 	}
 
 	payload, err := expandUptimeCheckRequestPayload(original["request_payload"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("http_check.0.request_payload") {
+	} else if hasChange(d, "http_check.0.request_payload") {
 		transformed["post_body"] = payload
 	}
 
+	// Both `request_payload` and `request_payload_json` are submitted as the
+	// post body. Only clear the post body when `request_payload` has not
+	// already set it.
+	payloadJSON, err := expandUptimeCheckRequestPayloadJSON(original["request_payload_json"], d)
+	if err != nil {
+		return nil, err
+	} else if hasChange(d, "http_check.0.request_payload_json") && (payloadJSON != "" || !hasChange(d, "http_check.0.request_payload")) {
+		transformed["post_body"] = payloadJSON
+	}
+
 	raw, err := expandUptimeCheckRequestRaw(original["request_payload_raw"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("http_check.0.request_payload_raw") {
+	} else if hasChange(d, "http_check.0.request_payload_raw") {
 		transformed["post_raw"] = raw
 	}
 
 	codes, err := expandUptimeCheckStatusCodes(original["status_codes"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("http_check.0.status_codes") {
+	} else if hasChange(d, "http_check.0.status_codes") {
 		transformed["status_codes_csv"] = codes
 	}
 
 	timeout, err := expandUptimeCheckTimeout(original["timeout"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("http_check.0.timeout") {
+	} else if hasChange(d, "http_check.0.timeout") {
 		transformed["timeout"] = timeout
 	}
 
 	userAgent, err := expandUptimeCheckUserAgent(original["user_agent"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("http_check.0.user_agent") {
+	} else if hasChange(d, "http_check.0.user_agent") {
 		transformed["user_agent"] = userAgent
 	}
 
 	validateSSL, err := expandUptimeCheckValidateSSL(original["validate_ssl"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("http_check.0.validate_ssl") {
+	} else if hasChange(d, "http_check.0.validate_ssl") {
 		transformed["enable_ssl_alert"] = validateSSL
 	}
 
@@ -977,19 +1483,21 @@ func flattenUptimeCheckHTTPCheck(v interface{}, d *schema.ResourceData) interfac
 
 	return []map[string]interface{}{
 		{
-			"basic_authentication": flattenUptimeCheckBasicAuthentication(d.Get("http_check.0.basic_authentication"), d),
-			"content_matchers":     flattenUptimeCheckContentMatchers(data, d),
-			"enable_cookies":       flattenUptimeCheckEnableCookies(data.UseJAR, d),
-			"final_endpoint":       flattenUptimeCheckFinalEndpoint(data.FinalEndpoint, d),
-			"follow_redirects":     flattenUptimeCheckFollowRedirects(data.FollowRedirects, d),
-			"request_headers":      flattenUptimeCheckRequestHeaders(data.CustomHeader, d),
-			"request_method":       flattenUptimeCheckRequestMethod(data.TestType, d),
-			"request_payload":      flattenUptimeCheckRequestPayload(data.PostBody, d),
-			"request_payload_raw":  flattenUptimeCheckRequestRaw(data.PostRaw, d),
-			"status_codes":         flattenUptimeCheckStatusCodes(data.StatusCodes, d),
-			"timeout":              flattenUptimeCheckTimeout(data.Timeout, d),
-			"user_agent":           flattenUptimeCheckUserAgent(data.UserAgent, d),
-			"validate_ssl":         flattenUptimeCheckValidateSSL(data.EnableSSLAlert, d),
+			"basic_authentication":      flattenUptimeCheckBasicAuthentication(d.Get("http_check.0.basic_authentication"), d),
+			"content_matchers":          flattenUptimeCheckContentMatchers(data, d),
+			"enable_cookies":            flattenUptimeCheckEnableCookies(data.UseJAR, d),
+			"final_endpoint":            flattenUptimeCheckFinalEndpoint(data.FinalEndpoint, d),
+			"follow_redirects":          flattenUptimeCheckFollowRedirects(data.FollowRedirects, d),
+			"request_headers":           flattenUptimeCheckRequestHeaders(data.CustomHeader, d),
+			"request_method":            flattenUptimeCheckRequestMethod(data.TestType, d),
+			"request_payload":           flattenUptimeCheckRequestPayload(data.PostBody, d),
+			"request_payload_json":      flattenUptimeCheckRequestPayloadJSON(data.PostBody, d),
+			"request_payload_raw":       flattenUptimeCheckRequestRaw(data.PostRaw, d),
+			"sensitive_request_headers": flattenUptimeCheckSensitiveRequestHeaders(d.Get("http_check.0.sensitive_request_headers"), d),
+			"status_codes":              flattenUptimeCheckStatusCodes(data.StatusCodes, d),
+			"timeout":                   flattenUptimeCheckTimeout(data.Timeout, d),
+			"user_agent":                flattenUptimeCheckUserAgent(data.UserAgent, d),
+			"validate_ssl":              flattenUptimeCheckValidateSSL(data.EnableSSLAlert, d),
 		},
 	}
 }
@@ -1003,6 +1511,12 @@ func flattenUptimeCheckHost(v interface{}, d *schema.ResourceData) interface{} {
 }
 
 func expandUptimeCheckICMPCheck(v interface{}, d *schema.ResourceData) (interface{}, error) {
+	l := v.([]interface{})
+
+	if len(l) == 0 {
+		return map[string]interface{}{}, nil
+	}
+
 	return map[string]interface{}{
 		"test_type": statuscake.UptimeTestTypePING,
 	}, nil
@@ -1014,11 +1528,7 @@ func flattenUptimeCheckICMPCheck(v interface{}, _ *schema.ResourceData) interfac
 		return nil
 	}
 
-	return []map[string]interface{}{
-		{
-			"enabled": true,
-		},
-	}
+	return []map[string]interface{}{{}}
 }
 
 func expandUptimeCheckIncludeHeaders(v interface{}, d *schema.ResourceData) (interface{}, error) {
@@ -1030,11 +1540,15 @@ func flattenUptimeCheckIncludeHeaders(v interface{}, d *schema.ResourceData) int
 }
 
 func expandUptimeCheckInterval(v interface{}, d *schema.ResourceData) (interface{}, error) {
-	return statuscake.UptimeTestCheckRate(v.(int)), nil
+	n, err := intvalidation.ParseDuration(v.(string), time.Second)
+	if err != nil {
+		return nil, err
+	}
+	return statuscake.UptimeTestCheckRate(n), nil
 }
 
 func flattenUptimeCheckInterval(v interface{}, d *schema.ResourceData) interface{} {
-	return int(v.(statuscake.UptimeTestCheckRate))
+	return strconv.Itoa(int(v.(statuscake.UptimeTestCheckRate)))
 }
 
 func expandUptimeCheckMatcher(v interface{}, _ *schema.ResourceData) (interface{}, error) {
@@ -1064,14 +1578,14 @@ func expandUptimeCheckMonitoredResource(v interface{}, d *schema.ResourceData) (
 	address, err := expandUptimeCheckAddress(original["address"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("monitored_resource.0.address") {
+	} else if hasChange(d, "monitored_resource.0.address") {
 		transformed["website_url"] = address
 	}
 
 	host, err := expandUptimeCheckHost(original["host"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("monitored_resource.0.host") {
+	} else if hasChange(d, "monitored_resource.0.host") {
 		transformed["host"] = host
 	}
 
@@ -1140,14 +1654,51 @@ func expandUptimeCheckRequestHeaders(v interface{}, _ *schema.ResourceData) (int
 	return string(b), nil
 }
 
-func flattenUptimeCheckRequestHeaders(v interface{}, _ *schema.ResourceData) interface{} {
-	var headers map[string]interface{}
-	if err := json.Unmarshal([]byte(stringElem(v)), &headers); err != nil {
+func flattenUptimeCheckRequestHeaders(v interface{}, d *schema.ResourceData) interface{} {
+	headers, err := decodeUptimeCheckRequestHeaders(stringElem(v))
+	if err != nil {
 		return map[string]interface{}{}
 	}
+
+	// Sensitive request headers are tracked by hash and must not be exposed
+	// as non-sensitive request headers.
+	for k := range d.Get("http_check.0.sensitive_request_headers").(map[string]interface{}) {
+		delete(headers, k)
+	}
+
 	return headers
 }
 
+// decodeUptimeCheckRequestHeaders decodes the JSON encoded custom headers
+// returned by the API. Header values that are not strings are retained using
+// their JSON representation rather than being discarded.
+func decodeUptimeCheckRequestHeaders(s string) (map[string]interface{}, error) {
+	headers := make(map[string]interface{})
+	if s == "" {
+		return headers, nil
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal([]byte(s), &raw); err != nil {
+		return nil, fmt.Errorf("custom headers are not a valid JSON object: %w", err)
+	}
+
+	for k, v := range raw {
+		if str, ok := v.(string); ok {
+			headers[k] = str
+			continue
+		}
+
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		headers[k] = string(b)
+	}
+
+	return headers, nil
+}
+
 func expandUptimeCheckRequestMethod(v interface{}, d *schema.ResourceData) (interface{}, error) {
 	return statuscake.UptimeTestType(v.(string)), nil
 }
@@ -1168,14 +1719,50 @@ func expandUptimeCheckRequestPayload(v interface{}, _ *schema.ResourceData) (int
 	return string(b), nil
 }
 
-func flattenUptimeCheckRequestPayload(v interface{}, _ *schema.ResourceData) interface{} {
-	var body map[string]interface{}
-	if err := json.Unmarshal([]byte(stringElem(v)), &body); err != nil {
+func flattenUptimeCheckRequestPayload(v interface{}, d *schema.ResourceData) interface{} {
+	body, ok := decodeUptimeCheckFlatPayload(stringElem(v))
+	if !ok || d.Get("http_check.0.request_payload_json").(string) != "" {
 		return map[string]interface{}{}
 	}
 	return body
 }
 
+func expandUptimeCheckRequestPayloadJSON(v interface{}, d *schema.ResourceData) (interface{}, error) {
+	return v.(string), nil
+}
+
+func flattenUptimeCheckRequestPayloadJSON(v interface{}, d *schema.ResourceData) interface{} {
+	// Payloads that can be represented by `request_payload` are only returned
+	// when `request_payload_json` is already in use.
+	body := stringElem(v)
+	if _, ok := decodeUptimeCheckFlatPayload(body); ok && d.Get("http_check.0.request_payload_json").(string) == "" {
+		return ""
+	}
+	return body
+}
+
+// decodeUptimeCheckFlatPayload decodes a JSON encoded payload that consists
+// only of string values. It returns false if the payload contains nested or
+// non-string values, since these cannot be represented as a map of strings.
+func decodeUptimeCheckFlatPayload(s string) (map[string]interface{}, bool) {
+	body := make(map[string]interface{})
+	if s == "" {
+		return body, true
+	}
+
+	if err := json.Unmarshal([]byte(s), &body); err != nil {
+		return nil, false
+	}
+
+	for _, v := range body {
+		if _, ok := v.(string); !ok {
+			return nil, false
+		}
+	}
+
+	return body, true
+}
+
 func expandUptimeCheckRequestRaw(v interface{}, d *schema.ResourceData) (interface{}, error) {
 	return v.(string), nil
 }
@@ -1189,13 +1776,130 @@ func expandUptimeCheckRegions(v interface{}, d *schema.ResourceData) (interface{
 }
 
 func expandUptimeCheckStatusCodes(v interface{}, d *schema.ResourceData) (interface{}, error) {
-	return strings.Join(convertStringSet(v.(*schema.Set)), ","), nil
+	codes, err := expandStatusCodes(convertStringSet(v.(*schema.Set)))
+	if err != nil {
+		return nil, err
+	}
+	return strings.Join(codes, ","), nil
 }
 
 func flattenUptimeCheckStatusCodes(v interface{}, d *schema.ResourceData) interface{} {
+	// The API returns every status code individually. Retain the configured
+	// classes and ranges where they represent the same status codes to avoid a
+	// perpetual diff.
+	configured := d.Get("http_check.0.status_codes").(*schema.Set)
+	if codes, err := expandStatusCodes(convertStringSet(configured)); err == nil && equalStringSets(codes, v.([]string)) {
+		return configured.List()
+	}
+	return v
+}
+
+// expandStatusCodes expands each status code, class or range into the
+// individual status codes it represents. The returned status codes are sorted
+// and deduplicated.
+func expandStatusCodes(entries []string) ([]string, error) {
+	seen := make(map[int]bool)
+	for _, entry := range entries {
+		codes, err := intvalidation.ParseStatusCodes(entry)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, code := range codes {
+			seen[code] = true
+		}
+	}
+
+	codes := make([]int, 0, len(seen))
+	for code := range seen {
+		codes = append(codes, code)
+	}
+	sort.Ints(codes)
+
+	s := make([]string, len(codes))
+	for i, code := range codes {
+		s[i] = strconv.Itoa(code)
+	}
+
+	return s, nil
+}
+
+func flattenUptimeCheckSensitiveRequestHeaders(v interface{}, d *schema.ResourceData) interface{} {
 	return v
 }
 
+// flattenUptimeCheckSensitiveRequestHeadersHash returns the hash of the
+// custom headers returned by the API that are configured as sensitive.
+func flattenUptimeCheckSensitiveRequestHeadersHash(v interface{}, d *schema.ResourceData) interface{} {
+	headers, err := decodeUptimeCheckRequestHeaders(stringElem(v))
+	if err != nil {
+		return ""
+	}
+
+	sensitive := make(map[string]interface{})
+	for k := range d.Get("http_check.0.sensitive_request_headers").(map[string]interface{}) {
+		if value, ok := headers[k]; ok {
+			sensitive[k] = value
+		}
+	}
+
+	// The salt of the previous hash is retained so that the hash only changes
+	// when the headers do.
+	salt, ok := uptimeCheckSensitiveRequestHeadersSalt(d.Get("sensitive_request_headers_hash").(string))
+	if !ok {
+		salt = make([]byte, sensitiveRequestHeadersSaltSize)
+		if _, err := rand.Read(salt); err != nil {
+			return ""
+		}
+	}
+
+	hash, err := hashUptimeCheckSensitiveRequestHeaders(sensitive, salt)
+	if err != nil {
+		return ""
+	}
+	return hash
+}
+
+// sensitiveRequestHeadersSaltSize is the number of random bytes with which the
+// sensitive request headers of each check are salted.
+const sensitiveRequestHeadersSaltSize = 16
+
+// hashUptimeCheckSensitiveRequestHeaders returns an HMAC-SHA256 of the given
+// headers keyed by the salt. The hash is prefixed by the salt, separated by a
+// colon, so that it can be recomputed from the configured headers. An empty
+// string is returned when there are no headers.
+func hashUptimeCheckSensitiveRequestHeaders(headers map[string]interface{}, salt []byte) (string, error) {
+	if len(headers) == 0 {
+		return "", nil
+	}
+
+	// Map keys are sorted when encoded which ensures the hash is stable.
+	b, err := json.Marshal(headers)
+	if err != nil {
+		return "", err
+	}
+
+	mac := hmac.New(sha256.New, salt)
+	mac.Write(b)
+	return hex.EncodeToString(salt) + ":" + hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// uptimeCheckSensitiveRequestHeadersSalt returns the salt of a hash returned by
+// hashUptimeCheckSensitiveRequestHeaders. It returns false if the hash is
+// empty or was not salted.
+func uptimeCheckSensitiveRequestHeadersSalt(hash string) ([]byte, bool) {
+	encoded, _, ok := strings.Cut(hash, ":")
+	if !ok {
+		return nil, false
+	}
+
+	salt, err := hex.DecodeString(encoded)
+	if err != nil || len(salt) != sensitiveRequestHeadersSaltSize {
+		return nil, false
+	}
+	return salt, true
+}
+
 func expandUptimeCheckTags(v interface{}, d *schema.ResourceData) (interface{}, error) {
 	return convertStringSet(v.(*schema.Set)), nil
 }
@@ -1217,28 +1921,28 @@ func expandUptimeCheckTCPCheck(v interface{}, d *schema.ResourceData) (interface
 	auth, err := expandUptimeCheckBasicAuthentication(original["authentication"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("tcp_check.0.authentication") {
+	} else if hasChange(d, "tcp_check.0.authentication") {
 		transformed = merge(transformed, auth.(map[string]interface{}))
 	}
 
 	port, err := expandUptimeCheckPort(original["port"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("tcp_check.0.port") {
+	} else if hasChange(d, "tcp_check.0.port") {
 		transformed["port"] = port
 	}
 
 	protocol, err := expandUptimeCheckProtocol(original["protocol"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("tcp_check.0.protocol") {
+	} else if hasChange(d, "tcp_check.0.protocol") {
 		transformed["test_type"] = protocol
 	}
 
 	timeout, err := expandUptimeCheckTimeout(original["timeout"], d)
 	if err != nil {
 		return nil, err
-	} else if d.HasChange("tcp_check.0.timeout") {
+	} else if hasChange(d, "tcp_check.0.timeout") {
 		transformed["timeout"] = timeout
 	}
 
@@ -1262,19 +1966,27 @@ func flattenUptimeCheckTCPCheck(v interface{}, d *schema.ResourceData) interface
 }
 
 func expandUptimeCheckTimeout(v interface{}, d *schema.ResourceData) (interface{}, error) {
-	return int32(v.(int)), nil
+	n, err := intvalidation.ParseDuration(v.(string), time.Second)
+	if err != nil {
+		return nil, err
+	}
+	return int32(n), nil
 }
 
 func flattenUptimeCheckTimeout(v interface{}, d *schema.ResourceData) interface{} {
-	return v
+	return strconv.Itoa(int(v.(int32)))
 }
 
 func expandUptimeCheckTriggerRate(v interface{}, d *schema.ResourceData) (interface{}, error) {
-	return int32(v.(int)), nil
+	n, err := intvalidation.ParseDuration(v.(string), time.Minute)
+	if err != nil {
+		return nil, err
+	}
+	return int32(n), nil
 }
 
 func flattenUptimeCheckTriggerRate(v interface{}, d *schema.ResourceData) interface{} {
-	return v
+	return strconv.Itoa(int(v.(int32)))
 }
 
 func expandUptimeCheckUserAgent(v interface{}, d *schema.ResourceData) (interface{}, error) {
@@ -0,0 +1,332 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/StatusCakeDev/statuscake-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// testSalt is a fixed salt with which sensitive request headers are hashed.
+var testSalt = bytes.Repeat([]byte{0xab}, sensitiveRequestHeadersSaltSize)
+
+// testUptimeCheckSensitiveRequestHeadersHash returns the hash of the headers
+// salted by testSalt.
+func testUptimeCheckSensitiveRequestHeadersHash(t *testing.T, headers map[string]interface{}) string {
+	t.Helper()
+
+	hash, err := hashUptimeCheckSensitiveRequestHeaders(headers, testSalt)
+	if err != nil {
+		t.Fatalf("failed to hash headers: %+v", err)
+	}
+	return hash
+}
+
+// testUptimeCheckConfig returns the configuration of an HTTP uptime check with
+// the given sensitive request headers.
+func testUptimeCheckConfig(sensitive map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"name":           "Website",
+		"check_interval": "300",
+		"http_check": []interface{}{
+			map[string]interface{}{
+				"sensitive_request_headers": sensitive,
+			},
+		},
+		"monitored_resource": []interface{}{
+			map[string]interface{}{
+				"address": "https://www.example.com",
+			},
+		},
+	}
+}
+
+func TestHashUptimeCheckSensitiveRequestHeaders(t *testing.T) {
+	otherSalt := bytes.Repeat([]byte{0xcd}, sensitiveRequestHeadersSaltSize)
+
+	tests := []struct {
+		name     string
+		a        map[string]interface{}
+		saltA    []byte
+		b        map[string]interface{}
+		saltB    []byte
+		expected bool
+	}{
+		{
+			name:     "is equal when the order of the headers differs",
+			a:        map[string]interface{}{"Authorization": "Bearer abc", "X-Api-Key": "123"},
+			saltA:    testSalt,
+			b:        map[string]interface{}{"X-Api-Key": "123", "Authorization": "Bearer abc"},
+			saltB:    testSalt,
+			expected: true,
+		},
+		{
+			name:     "differs when a value changes",
+			a:        map[string]interface{}{"Authorization": "Bearer abc"},
+			saltA:    testSalt,
+			b:        map[string]interface{}{"Authorization": "Bearer xyz"},
+			saltB:    testSalt,
+			expected: false,
+		},
+		{
+			name:     "differs when a header is added",
+			a:        map[string]interface{}{"Authorization": "Bearer abc"},
+			saltA:    testSalt,
+			b:        map[string]interface{}{"Authorization": "Bearer abc", "X-Api-Key": "123"},
+			saltB:    testSalt,
+			expected: false,
+		},
+		{
+			name:     "differs when the salt changes",
+			a:        map[string]interface{}{"Authorization": "Bearer abc"},
+			saltA:    testSalt,
+			b:        map[string]interface{}{"Authorization": "Bearer abc"},
+			saltB:    otherSalt,
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := hashUptimeCheckSensitiveRequestHeaders(tt.a, tt.saltA)
+			if err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+
+			b, err := hashUptimeCheckSensitiveRequestHeaders(tt.b, tt.saltB)
+			if err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+
+			if actual := a == b; actual != tt.expected {
+				t.Errorf("expected equal hashes to be %t but got %q and %q", tt.expected, a, b)
+			}
+		})
+	}
+
+	t.Run("returns an empty hash when there are no headers", func(t *testing.T) {
+		hash, err := hashUptimeCheckSensitiveRequestHeaders(nil, testSalt)
+		if err != nil || hash != "" {
+			t.Errorf("expected an empty hash but got %q and error: %v", hash, err)
+		}
+	})
+
+	t.Run("does not contain the header values", func(t *testing.T) {
+		hash := testUptimeCheckSensitiveRequestHeadersHash(t, map[string]interface{}{"Authorization": "Bearer abc"})
+		if strings.Contains(hash, "Bearer") {
+			t.Errorf("expected the hash not to contain the header value but got %q", hash)
+		}
+	})
+}
+
+func TestUptimeCheckSensitiveRequestHeadersSalt(t *testing.T) {
+	tests := []struct {
+		name     string
+		hash     string
+		expected []byte
+		ok       bool
+	}{
+		{name: "returns the salt of a salted hash", hash: "abababababababababababababababab:0123", expected: testSalt, ok: true},
+		{name: "returns false for an empty hash", hash: ""},
+		{name: "returns false for an unsalted hash", hash: "0123456789abcdef"},
+		{name: "returns false for a salt that is not hexadecimal", hash: "salt:0123"},
+		{name: "returns false for a salt of the wrong size", hash: "abab:0123"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			salt, ok := uptimeCheckSensitiveRequestHeadersSalt(tt.hash)
+			if ok != tt.ok || !bytes.Equal(salt, tt.expected) {
+				t.Errorf("expected %x and %t but got %x and %t", tt.expected, tt.ok, salt, ok)
+			}
+		})
+	}
+}
+
+func TestFlattenUptimeCheckSensitiveRequestHeadersHash(t *testing.T) {
+	r := resourceStatusCakeUptimeCheck()
+	sensitive := map[string]interface{}{"Authorization": "Bearer abc"}
+	headers := `{"Authorization":"Bearer abc","Accept":"text/html"}`
+
+	t.Run("reuses the salt of the previous hash", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, r.Schema, testUptimeCheckConfig(sensitive))
+		previous := testUptimeCheckSensitiveRequestHeadersHash(t, map[string]interface{}{"Authorization": "Bearer old"})
+		if err := d.Set("sensitive_request_headers_hash", previous); err != nil {
+			t.Fatalf("failed to set hash: %+v", err)
+		}
+
+		expected := testUptimeCheckSensitiveRequestHeadersHash(t, sensitive)
+		if actual := flattenUptimeCheckSensitiveRequestHeadersHash(&headers, d); actual != expected {
+			t.Errorf("expected %q but got %q", expected, actual)
+		}
+	})
+
+	t.Run("generates a salt when there is no previous hash", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, r.Schema, testUptimeCheckConfig(sensitive))
+
+		actual := flattenUptimeCheckSensitiveRequestHeadersHash(&headers, d).(string)
+		salt, ok := uptimeCheckSensitiveRequestHeadersSalt(actual)
+		if !ok {
+			t.Fatalf("expected a salted hash but got %q", actual)
+		}
+
+		expected, err := hashUptimeCheckSensitiveRequestHeaders(sensitive, salt)
+		if err != nil {
+			t.Fatalf("failed to hash headers: %+v", err)
+		}
+
+		if actual != expected {
+			t.Errorf("expected %q but got %q", expected, actual)
+		}
+	})
+
+	t.Run("only hashes the headers configured as sensitive", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, r.Schema, testUptimeCheckConfig(sensitive))
+		if err := d.Set("sensitive_request_headers_hash", testUptimeCheckSensitiveRequestHeadersHash(t, sensitive)); err != nil {
+			t.Fatalf("failed to set hash: %+v", err)
+		}
+
+		other := `{"Authorization":"Bearer abc","Accept":"application/json"}`
+		if flattenUptimeCheckSensitiveRequestHeadersHash(&headers, d) != flattenUptimeCheckSensitiveRequestHeadersHash(&other, d) {
+			t.Error("expected headers not configured as sensitive to be ignored")
+		}
+	})
+}
+
+func TestResourceStatusCakeUptimeCheckSensitiveRequestHeadersDiff(t *testing.T) {
+	// Only the sensitive request headers diff is run so that the diff does not
+	// depend on the provider configuration.
+	r := &schema.Resource{
+		Schema:        resourceStatusCakeUptimeCheck().Schema,
+		CustomizeDiff: resourceStatusCakeUptimeCheckSensitiveRequestHeadersDiff,
+	}
+
+	sensitive := map[string]interface{}{"Authorization": "Bearer abc"}
+	hash := testUptimeCheckSensitiveRequestHeadersHash(t, sensitive)
+
+	tests := []struct {
+		name      string
+		previous  string
+		sensitive map[string]interface{}
+		expected  *terraform.ResourceAttrDiff
+	}{
+		{
+			name:      "does not change the hash when the headers are unchanged",
+			previous:  hash,
+			sensitive: sensitive,
+			expected:  nil,
+		},
+		{
+			name:      "changes the hash, keeping its salt, when a value changes",
+			previous:  hash,
+			sensitive: map[string]interface{}{"Authorization": "Bearer xyz"},
+			expected: &terraform.ResourceAttrDiff{
+				Old: hash,
+				New: testUptimeCheckSensitiveRequestHeadersHash(t, map[string]interface{}{"Authorization": "Bearer xyz"}),
+			},
+		},
+		{
+			name:      "marks the hash as unknown when it has no salt",
+			previous:  "",
+			sensitive: sensitive,
+			expected:  &terraform.ResourceAttrDiff{NewComputed: true},
+		},
+		{
+			// An empty value of a computed attribute is planned as unknown and
+			// cleared once the check has been read after the update.
+			name:      "marks the hash as unknown when the sensitive headers are removed",
+			previous:  hash,
+			sensitive: map[string]interface{}{},
+			expected:  &terraform.ResourceAttrDiff{Old: hash, NewComputed: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := testUptimeCheckState(t, r, tt.previous)
+			config := terraform.NewResourceConfigRaw(testUptimeCheckConfig(tt.sensitive))
+
+			diff, err := r.Diff(context.Background(), state, config, nil)
+			if err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+
+			var actual *terraform.ResourceAttrDiff
+			if diff != nil {
+				actual = diff.Attributes["sensitive_request_headers_hash"]
+			}
+
+			switch {
+			case tt.expected == nil && actual != nil:
+				t.Errorf("expected no change to the hash but got %#v", actual)
+			case tt.expected != nil && actual == nil:
+				t.Errorf("expected a change to the hash but got none")
+			case tt.expected != nil && (actual.Old != tt.expected.Old || actual.New != tt.expected.New || actual.NewComputed != tt.expected.NewComputed):
+				t.Errorf("unexpected change to the hash\nexpected: %#v\nactual:   %#v", tt.expected, actual)
+			}
+		})
+	}
+
+	t.Run("rejects headers that are also specified as request headers", func(t *testing.T) {
+		config := testUptimeCheckConfig(sensitive)
+		config["http_check"].([]interface{})[0].(map[string]interface{})["request_headers"] = map[string]interface{}{"Authorization": "Bearer abc"}
+
+		_, err := r.Diff(context.Background(), testUptimeCheckState(t, r, hash), terraform.NewResourceConfigRaw(config), nil)
+		if err == nil || !strings.Contains(err.Error(), `header "Authorization" is also specified`) {
+			t.Errorf("expected an error but got: %v", err)
+		}
+	})
+}
+
+// testUptimeCheckState returns the state of an uptime check whose sensitive
+// request headers were last read with the given hash.
+func testUptimeCheckState(t *testing.T, r *schema.Resource, hash string) *terraform.InstanceState {
+	t.Helper()
+
+	d := schema.TestResourceDataRaw(t, r.Schema, testUptimeCheckConfig(map[string]interface{}{"Authorization": "Bearer abc"}))
+	d.SetId("1")
+	if err := d.Set("sensitive_request_headers_hash", hash); err != nil {
+		t.Fatalf("failed to set hash: %+v", err)
+	}
+	return d.State()
+}
+
+func TestIsUptimeCheckResultSuccessful(t *testing.T) {
+	statusCode := func(code int32) *int32 {
+		return &code
+	}
+
+	tests := []struct {
+		name     string
+		testType statuscake.UptimeTestType
+		code     *int32
+		expected bool
+	}{
+		{name: "HTTP result with a status code that does not alert", testType: statuscake.UptimeTestTypeHTTP, code: statusCode(200), expected: true},
+		{name: "HEAD result with a status code that does not alert", testType: statuscake.UptimeTestTypeHEAD, code: statusCode(301), expected: true},
+		{name: "HTTP result with a status code that alerts", testType: statuscake.UptimeTestTypeHTTP, code: statusCode(503), expected: false},
+		{name: "HTTP result without a status code", testType: statuscake.UptimeTestTypeHTTP, code: nil, expected: false},
+		{name: "HTTP result with a zero status code", testType: statuscake.UptimeTestTypeHTTP, code: statusCode(0), expected: false},
+		{name: "TCP result without a status code", testType: statuscake.UptimeTestTypeTCP, code: nil, expected: true},
+		{name: "PING result without a status code", testType: statuscake.UptimeTestTypePING, code: nil, expected: true},
+		{name: "DNS result with a status code that would alert an HTTP check", testType: statuscake.UptimeTestTypeDNS, code: statusCode(503), expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := statuscake.UptimeTest{
+				TestType:    tt.testType,
+				StatusCodes: []string{"404", "500", "503"},
+			}
+			result := statuscake.UptimeTestHistoryResult{StatusCode: tt.code}
+
+			if actual := isUptimeCheckResultSuccessful(check, result); actual != tt.expected {
+				t.Errorf("expected %t but got %t", tt.expected, actual)
+			}
+		})
+	}
+}
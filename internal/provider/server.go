@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var _ tfprotov5.ProviderServerWithActions = (*providerServer)(nil)
+
+// providerServer is a tfprotov5.ProviderServer that serves the actions of the
+// provider and moves of legacy resources alongside the resources and data
+// sources served by the SDK.
+type providerServer struct {
+	*schema.GRPCProviderServer
+
+	provider *schema.Provider
+}
+
+// ProviderServer returns the server for the provider, including its actions
+// and resource moves.
+func ProviderServer() tfprotov5.ProviderServer {
+	p := Provider()
+	return &providerServer{
+		GRPCProviderServer: schema.NewGRPCProviderServer(p),
+		provider:           p,
+	}
+}
+
+func (s *providerServer) GetMetadata(ctx context.Context, req *tfprotov5.GetMetadataRequest) (*tfprotov5.GetMetadataResponse, error) {
+	resp, err := s.GRPCProviderServer.GetMetadata(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.ServerCapabilities = withMoveResourceState(resp.ServerCapabilities)
+
+	for _, typeName := range slices.Sorted(maps.Keys(actions())) {
+		resp.Actions = append(resp.Actions, tfprotov5.ActionMetadata{TypeName: typeName})
+	}
+
+	return resp, nil
+}
+
+func (s *providerServer) GetProviderSchema(ctx context.Context, req *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	resp, err := s.GRPCProviderServer.GetProviderSchema(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.ServerCapabilities = withMoveResourceState(resp.ServerCapabilities)
+
+	resp.ActionSchemas = make(map[string]*tfprotov5.ActionSchema)
+	for typeName, paused := range actions() {
+		resp.ActionSchemas[typeName] = checksActionSchema(paused)
+	}
+
+	return resp, nil
+}
+
+// withMoveResourceState returns the server capabilities with support for
+// moving resource state enabled.
+func withMoveResourceState(c *tfprotov5.ServerCapabilities) *tfprotov5.ServerCapabilities {
+	capabilities := tfprotov5.ServerCapabilities{}
+	if c != nil {
+		capabilities = *c
+	}
+	capabilities.MoveResourceState = true
+	return &capabilities
+}
+
+// errorDiagnostics returns the error as protocol diagnostics.
+func errorDiagnostics(summary string, err error) []*tfprotov5.Diagnostic {
+	return []*tfprotov5.Diagnostic{
+		{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  summary,
+			Detail:   err.Error(),
+		},
+	}
+}
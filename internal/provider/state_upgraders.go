@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The schema version of a resource is the number of state upgrades registered
+// against it. Each upgrade receives the raw state, decoded from JSON, as it was
+// stored by the previous schema version and returns the state of the next
+// version. Upgrades are applied in turn by Terraform, so a state stored by any
+// prior version is brought up to date before the resource is read.
+//
+// Every resource is registered with withStateUpgrades, so a resource without
+// any upgrades is at version zero. New upgrades are appended to the end of the
+// list passed to withStateUpgrades and existing upgrades are never modified.
+// Attributes must not be removed from, or change type within, the current
+// schema since every prior version is described by the current schema type.
+// Attributes that are no longer used are instead deprecated in the schema and
+// removed from the state by an upgrade.
+
+// withStateUpgrades sets the schema version of the resource and registers each
+// upgrade, in order, from version zero.
+func withStateUpgrades(r *schema.Resource, upgrades ...schema.StateUpgradeFunc) *schema.Resource {
+	ty := r.CoreConfigSchema().ImpliedType()
+
+	r.SchemaVersion = len(upgrades)
+	r.StateUpgraders = make([]schema.StateUpgrader, len(upgrades))
+	for i, upgrade := range upgrades {
+		r.StateUpgraders[i] = schema.StateUpgrader{
+			Version: i,
+			Type:    ty,
+			Upgrade: upgrade,
+		}
+	}
+	return r
+}
+
+// upgradeBlocks calls fn with each element of the nested block within the raw
+// state. Missing and empty blocks are ignored.
+func upgradeBlocks(rawState map[string]interface{}, key string, fn func(map[string]interface{})) {
+	blocks, _ := rawState[key].([]interface{})
+	for _, block := range blocks {
+		if m, ok := block.(map[string]interface{}); ok {
+			fn(m)
+		}
+	}
+}
+
+// upgradeUptimeCheckStateV0 removes the enabled attribute from the icmp_check
+// block. The attribute only existed to allow the block to be declared and was
+// always stored as true. It is kept in the schema, and so read as null, only
+// so that existing configurations setting it remain valid.
+func upgradeUptimeCheckStateV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	upgradeBlocks(rawState, "icmp_check", func(check map[string]interface{}) {
+		delete(check, "enabled")
+	})
+	return rawState, nil
+}
+
+// upgradeUptimeCheckStateV1 normalizes the status codes stored within the
+// http_check block. Comma separated entries are split, each entry is trimmed
+// and lowercased, and duplicate entries are removed.
+func upgradeUptimeCheckStateV1(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	upgradeBlocks(rawState, "http_check", func(check map[string]interface{}) {
+		entries, ok := check["status_codes"].([]interface{})
+		if !ok {
+			return
+		}
+
+		var codes []interface{}
+		for _, entry := range entries {
+			s, ok := entry.(string)
+			if !ok {
+				continue
+			}
+
+			for _, code := range strings.Split(s, ",") {
+				if code = normalizeStatusCode(code); code != "" && !slices.Contains(codes, interface{}(code)) {
+					codes = append(codes, code)
+				}
+			}
+		}
+		check["status_codes"] = codes
+	})
+	return rawState, nil
+}
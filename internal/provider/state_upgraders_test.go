@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// stateUpgradeTest describes the raw state expected to be returned by a state
+// upgrade given the raw state stored by the previous schema version.
+type stateUpgradeTest struct {
+	name     string
+	rawState map[string]interface{}
+	expected map[string]interface{}
+}
+
+// testStateUpgrade runs each test against the state upgrade.
+func testStateUpgrade(t *testing.T, upgrade schema.StateUpgradeFunc, tests []stateUpgradeTest) {
+	t.Helper()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := upgrade(context.Background(), tt.rawState, nil)
+			if err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("unexpected state\nexpected: %#v\nactual:   %#v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestWithStateUpgrades(t *testing.T) {
+	for name, r := range Provider().ResourcesMap {
+		t.Run(name, func(t *testing.T) {
+			if r.SchemaVersion != len(r.StateUpgraders) {
+				t.Errorf("expected schema version %d but got %d", len(r.StateUpgraders), r.SchemaVersion)
+			}
+
+			for i, upgrader := range r.StateUpgraders {
+				if upgrader.Version != i {
+					t.Errorf("expected upgrader %d to have version %d but got %d", i, i, upgrader.Version)
+				}
+			}
+		})
+	}
+}
+
+func TestUpgradeUptimeCheckStateV0(t *testing.T) {
+	testStateUpgrade(t, upgradeUptimeCheckStateV0, []stateUpgradeTest{
+		{
+			name: "removes the enabled attribute from the icmp_check block",
+			rawState: map[string]interface{}{
+				"id":         "1",
+				"icmp_check": []interface{}{map[string]interface{}{"enabled": true}},
+			},
+			expected: map[string]interface{}{
+				"id":         "1",
+				"icmp_check": []interface{}{map[string]interface{}{}},
+			},
+		},
+		{
+			name: "ignores an empty icmp_check block",
+			rawState: map[string]interface{}{
+				"id":         "1",
+				"icmp_check": []interface{}{},
+			},
+			expected: map[string]interface{}{
+				"id":         "1",
+				"icmp_check": []interface{}{},
+			},
+		},
+		{
+			name: "ignores a missing icmp_check block",
+			rawState: map[string]interface{}{
+				"id":         "1",
+				"http_check": []interface{}{map[string]interface{}{"timeout": "15"}},
+			},
+			expected: map[string]interface{}{
+				"id":         "1",
+				"http_check": []interface{}{map[string]interface{}{"timeout": "15"}},
+			},
+		},
+	})
+}
+
+func TestUpgradeUptimeCheckStateV1(t *testing.T) {
+	testStateUpgrade(t, upgradeUptimeCheckStateV1, []stateUpgradeTest{
+		{
+			name: "retains normalized status codes",
+			rawState: map[string]interface{}{
+				"http_check": []interface{}{map[string]interface{}{"status_codes": []interface{}{"404", "5xx", "400-403"}}},
+			},
+			expected: map[string]interface{}{
+				"http_check": []interface{}{map[string]interface{}{"status_codes": []interface{}{"404", "5xx", "400-403"}}},
+			},
+		},
+		{
+			name: "lowercases status code classes",
+			rawState: map[string]interface{}{
+				"http_check": []interface{}{map[string]interface{}{"status_codes": []interface{}{"4XX", "5xX"}}},
+			},
+			expected: map[string]interface{}{
+				"http_check": []interface{}{map[string]interface{}{"status_codes": []interface{}{"4xx", "5xx"}}},
+			},
+		},
+		{
+			name: "splits comma separated status codes",
+			rawState: map[string]interface{}{
+				"http_check": []interface{}{map[string]interface{}{"status_codes": []interface{}{"404, 500,", " 503"}}},
+			},
+			expected: map[string]interface{}{
+				"http_check": []interface{}{map[string]interface{}{"status_codes": []interface{}{"404", "500", "503"}}},
+			},
+		},
+		{
+			name: "removes duplicate status codes",
+			rawState: map[string]interface{}{
+				"http_check": []interface{}{map[string]interface{}{"status_codes": []interface{}{"5xx", "404", "5XX", "404"}}},
+			},
+			expected: map[string]interface{}{
+				"http_check": []interface{}{map[string]interface{}{"status_codes": []interface{}{"5xx", "404"}}},
+			},
+		},
+		{
+			name: "ignores missing status codes",
+			rawState: map[string]interface{}{
+				"http_check": []interface{}{map[string]interface{}{"timeout": "15"}},
+			},
+			expected: map[string]interface{}{
+				"http_check": []interface{}{map[string]interface{}{"timeout": "15"}},
+			},
+		},
+		{
+			name: "ignores checks other than HTTP checks",
+			rawState: map[string]interface{}{
+				"icmp_check": []interface{}{map[string]interface{}{}},
+			},
+			expected: map[string]interface{}{
+				"icmp_check": []interface{}{map[string]interface{}{}},
+			},
+		},
+	})
+}
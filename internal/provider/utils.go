@@ -2,8 +2,14 @@ package provider
 
 import (
 	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	intvalidation "github.com/StatusCakeDev/terraform-provider-statuscake/v2/internal/provider/validation"
 )
 
 func convertStringSet(set *schema.Set) []string {
@@ -33,6 +39,82 @@ func convertStringList(list []interface{}) []string {
 	return s
 }
 
+// normalizeDuration returns a SchemaStateFunc that stores a duration as a
+// whole number of the given unit. This ensures equivalent values such as "5m"
+// and "300" do not produce a diff.
+func normalizeDuration(unit time.Duration) schema.SchemaStateFunc {
+	return func(v interface{}) string {
+		n, err := intvalidation.ParseDuration(v.(string), unit)
+		if err != nil {
+			return v.(string)
+		}
+		return strconv.Itoa(n)
+	}
+}
+
+// withSchemaDefaults returns the attributes with the default value of each
+// attribute that has not been given. Defaults are also applied to each element
+// of the nested blocks that have been given.
+func withSchemaDefaults(s map[string]*schema.Schema, attrs map[string]interface{}) map[string]interface{} {
+	m := make(map[string]interface{}, len(s))
+	for key, v := range attrs {
+		m[key] = v
+	}
+
+	for key, sch := range s {
+		v, ok := m[key]
+		if !ok {
+			if sch.Default != nil {
+				m[key] = sch.Default
+			}
+			continue
+		}
+
+		elem, isBlock := sch.Elem.(*schema.Resource)
+		blocks, isList := v.([]interface{})
+		if !isBlock || !isList {
+			continue
+		}
+
+		withDefaults := make([]interface{}, len(blocks))
+		for i, block := range blocks {
+			if block, ok := block.(map[string]interface{}); ok {
+				withDefaults[i] = withSchemaDefaults(elem.SchemaMap(), block)
+			} else {
+				withDefaults[i] = block
+			}
+		}
+		m[key] = withDefaults
+	}
+	return m
+}
+
+// normalizeStatusCode returns the status code, class or range in the form in
+// which it is stored. This ensures equivalent values such as "5XX" and "5xx" do
+// not produce a diff.
+func normalizeStatusCode(v interface{}) string {
+	return strings.ToLower(strings.TrimSpace(v.(string)))
+}
+
+// equalStringSets reports whether a and b contain the same elements,
+// regardless of order or duplication.
+func equalStringSets(a, b []string) bool {
+	setA := make(map[string]bool, len(a))
+	for _, v := range a {
+		setA[v] = true
+	}
+
+	setB := make(map[string]bool, len(b))
+	for _, v := range b {
+		if !setA[v] {
+			return false
+		}
+		setB[v] = true
+	}
+
+	return len(setA) == len(setB)
+}
+
 func stringElem(v interface{}) string {
 	val := reflect.Indirect(reflect.ValueOf(v))
 	if v == nil || isEmptyValue(val) || val.IsZero() {
@@ -83,3 +165,61 @@ func merge(maps ...map[string]interface{}) map[string]interface{} {
 	}
 	return merged
 }
+
+// closest returns up to n candidates ordered by their edit distance from s.
+// Candidates at an equal distance are ordered lexically.
+func closest(s string, candidates []string, n int) []string {
+	type match struct {
+		value    string
+		distance int
+	}
+
+	matches := make([]match, len(candidates))
+	for i, c := range candidates {
+		matches[i] = match{c, levenshtein(strings.ToLower(s), strings.ToLower(c))}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].distance == matches[j].distance {
+			return matches[i].value < matches[j].value
+		}
+		return matches[i].distance < matches[j].distance
+	})
+
+	if len(matches) > n {
+		matches = matches[:n]
+	}
+
+	values := make([]string, len(matches))
+	for i, m := range matches {
+		values[i] = m.value
+	}
+
+	return values
+}
+
+// levenshtein returns the number of single character edits required to turn
+// a into b.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	prev := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr := make([]int, len(rb)+1)
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev = curr
+	}
+
+	return prev[len(rb)]
+}
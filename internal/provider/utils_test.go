@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestClosest(t *testing.T) {
+	tests := []struct {
+		name       string
+		s          string
+		candidates []string
+		n          int
+		expected   []string
+	}{
+		{
+			name:       "orders candidates by edit distance",
+			s:          "GBR",
+			candidates: []string{"USA", "GB", "GBP", "FRA"},
+			n:          3,
+			expected:   []string{"GB", "GBP", "FRA"},
+		},
+		{
+			name:       "ignores case when comparing candidates",
+			s:          "usa",
+			candidates: []string{"USA", "US"},
+			n:          1,
+			expected:   []string{"USA"},
+		},
+		{
+			name:       "orders candidates at an equal distance lexically",
+			s:          "AB",
+			candidates: []string{"AD", "AC", "XB"},
+			n:          3,
+			expected:   []string{"AC", "AD", "XB"},
+		},
+		{
+			name:       "returns every candidate when there are fewer than n",
+			s:          "UK",
+			candidates: []string{"US"},
+			n:          3,
+			expected:   []string{"US"},
+		},
+		{
+			name:       "returns no candidates when there are none",
+			s:          "UK",
+			candidates: nil,
+			n:          3,
+			expected:   []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := closest(tt.s, tt.candidates, tt.n); !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("expected %v but got %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{a: "", b: "", expected: 0},
+		{a: "", b: "abc", expected: 3},
+		{a: "abc", b: "", expected: 3},
+		{a: "abc", b: "abc", expected: 0},
+		{a: "kitten", b: "sitting", expected: 3},
+		{a: "flaw", b: "lawn", expected: 2},
+		{a: "GB", b: "gb", expected: 2},
+		{a: "zürich", b: "zurich", expected: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			if actual := levenshtein(tt.a, tt.b); actual != tt.expected {
+				t.Errorf("expected %d but got %d", tt.expected, actual)
+			}
+		})
+	}
+}
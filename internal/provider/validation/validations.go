@@ -4,6 +4,11 @@ import (
 	"fmt"
 	"net/mail"
 	"strconv"
+	"strings"
+	"time"
+	// Embed the IANA time zone database so that time zones can be validated
+	// on systems without one installed.
+	_ "time/tzdata"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -64,3 +69,322 @@ func Int32InSlice(valid []int32) schema.SchemaValidateFunc {
 		return nil, []error{fmt.Errorf("expected %q to be one of %+v, got %d", k, valid, v)}
 	}
 }
+
+// ParseDuration parses a string representing either a whole number of the
+// given unit or a duration such as "5m" or "1h". It returns the number of whole
+// units represented by the string.
+func ParseDuration(s string, unit time.Duration) (int, error) {
+	if n, err := strconv.Atoi(s); err == nil {
+		return n, nil
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("expected a number of %s or a duration, got %q", unitName(unit), s)
+	}
+
+	if d%unit != 0 {
+		return 0, fmt.Errorf("expected a whole number of %s, got %q", unitName(unit), s)
+	}
+
+	return int(d / unit), nil
+}
+
+// DurationInSlice returns a SchemaValidateFunc that tests if the provided
+// value is of type string, represents a duration (see ParseDuration) and
+// matches the value of an element in the valid slice. When the value does not
+// match then the nearest valid value is reported.
+func DurationInSlice(valid []int32, unit time.Duration) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) ([]string, []error) {
+		v, ok := i.(string)
+		if !ok {
+			return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+		}
+
+		n, err := ParseDuration(v, unit)
+		if err != nil {
+			return nil, []error{fmt.Errorf("expected %q to be a valid duration: %+v", k, err)}
+		}
+
+		nearest := valid[0]
+		for _, validInt := range valid {
+			if int32(n) == validInt {
+				return nil, nil
+			}
+
+			if abs(int64(n)-int64(validInt)) < abs(int64(n)-int64(nearest)) {
+				nearest = validInt
+			}
+		}
+
+		return nil, []error{fmt.Errorf("expected %q to be one of %+v %s, got %d: the nearest allowed value is %d (%s)", k, valid, unitName(unit), n, nearest, time.Duration(nearest)*unit)}
+	}
+}
+
+// DurationBetween returns a SchemaValidateFunc that tests if the provided
+// value is of type string, represents a duration (see ParseDuration) and is
+// between min and max units (inclusive).
+func DurationBetween(min, max int, unit time.Duration) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) ([]string, []error) {
+		v, ok := i.(string)
+		if !ok {
+			return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+		}
+
+		n, err := ParseDuration(v, unit)
+		if err != nil {
+			return nil, []error{fmt.Errorf("expected %q to be a valid duration: %+v", k, err)}
+		}
+
+		if n < min || n > max {
+			return nil, []error{fmt.Errorf("expected %q to be in the range (%d - %d) %s, got %d", k, min, max, unitName(unit), n)}
+		}
+
+		return nil, nil
+	}
+}
+
+func unitName(unit time.Duration) string {
+	switch unit {
+	case time.Minute:
+		return "minutes"
+	case time.Hour:
+		return "hours"
+	default:
+		return "seconds"
+	}
+}
+
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// ParseStatusCodes parses a string representing a single HTTP status code such
+// as "404", a class of status codes such as "5xx", or an inclusive range of
+// status codes such as "400-404". It returns each status code represented by
+// the string in ascending order.
+func ParseStatusCodes(s string) ([]int, error) {
+	var lower, upper int
+
+	switch {
+	case len(s) == 3 && strings.EqualFold(s[1:], "xx"):
+		class, err := strconv.Atoi(s[:1])
+		if err != nil {
+			return nil, fmt.Errorf("expected a status code class such as 5xx, got %q", s)
+		}
+		lower, upper = class*100, class*100+99
+	case strings.Contains(s, "-"):
+		bounds := strings.SplitN(s, "-", 2)
+
+		var err error
+		if lower, err = strconv.Atoi(bounds[0]); err != nil {
+			return nil, fmt.Errorf("expected a status code range such as 400-404, got %q", s)
+		}
+
+		if upper, err = strconv.Atoi(bounds[1]); err != nil {
+			return nil, fmt.Errorf("expected a status code range such as 400-404, got %q", s)
+		}
+
+		if lower > upper {
+			return nil, fmt.Errorf("expected the start of the status code range to not exceed the end, got %q", s)
+		}
+	default:
+		code, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, fmt.Errorf("expected a status code, class or range, got %q", s)
+		}
+		lower, upper = code, code
+	}
+
+	if lower < 100 || upper > 599 {
+		return nil, fmt.Errorf("expected status codes to be in the range (100 - 599), got %q", s)
+	}
+
+	codes := make([]int, 0, upper-lower+1)
+	for code := lower; code <= upper; code++ {
+		codes = append(codes, code)
+	}
+
+	return codes, nil
+}
+
+// IsStatusCodes is a SchemaValidateFunc that tests if the provided value is
+// of type string and represents one or more HTTP status codes (see
+// ParseStatusCodes).
+func IsStatusCodes(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	if v == "" {
+		return nil, []error{fmt.Errorf("expected %q status codes to not be empty, got %q", k, i)}
+	}
+
+	if _, err := ParseStatusCodes(v); err != nil {
+		return nil, []error{fmt.Errorf("expected %q to be valid status codes: %+v", k, err)}
+	}
+
+	return nil, nil
+}
+
+// IsTimezone is a SchemaValidateFunc that tests if the provided value is of
+// type string and is the name of a time zone within the IANA time zone
+// database, such as "Europe/London".
+func IsTimezone(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	// The empty string and "Local" are accepted by time.LoadLocation but do
+	// not name a time zone within the database.
+	if v == "" || v == "Local" {
+		return nil, []error{fmt.Errorf("expected %q to be a valid IANA time zone, got %q", k, v)}
+	}
+
+	if _, err := time.LoadLocation(v); err != nil {
+		return nil, []error{fmt.Errorf("expected %q to be a valid IANA time zone, got %q", k, v)}
+	}
+
+	return nil, nil
+}
+
+// localDateTimeLayouts are the layouts accepted by ParseLocalDateTime.
+var localDateTimeLayouts = []string{
+	"2006-01-02T15:04",
+	"2006-01-02T15:04:05",
+}
+
+// ParseLocalDateTime parses a string representing a wall clock date and time
+// without an offset, such as "2026-11-01T02:00", and interprets it within the
+// given location.
+func ParseLocalDateTime(s string, loc *time.Location) (time.Time, error) {
+	for _, layout := range localDateTimeLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("expected a local date and time such as \"2006-01-02T15:04\", got %q", s)
+}
+
+// ParseExactLocalDateTime parses a wall clock date and time like
+// ParseLocalDateTime but returns an error unless it occurs exactly once within
+// the given location. Wall clock times are skipped when clocks go forward and
+// repeated when clocks go back, such as for daylight saving time.
+func ParseExactLocalDateTime(s string, loc *time.Location) (time.Time, error) {
+	wall, err := ParseLocalDateTime(s, time.UTC)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	t, err := ParseLocalDateTime(s, loc)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if !wallClock(t).Equal(wall) {
+		return time.Time{}, fmt.Errorf("expected a local date and time that occurs within %s, got %q which is skipped when the clocks go forward", loc, s)
+	}
+
+	// The wall clock time is repeated if it also occurs at the offset in use
+	// either side of t.
+	for _, other := range []time.Time{t.AddDate(0, 0, -1), t.AddDate(0, 0, 1)} {
+		_, offset := other.Zone()
+		if u := wall.Add(-time.Duration(offset) * time.Second); !u.Equal(t) && wallClock(u.In(loc)).Equal(wall) {
+			return time.Time{}, fmt.Errorf("expected a local date and time that occurs once within %s, got %q which is repeated when the clocks go back", loc, s)
+		}
+	}
+
+	return t, nil
+}
+
+// FormatLocalDateTime returns the wall clock date and time of t in the form
+// accepted by ParseLocalDateTime, such as "2026-11-01T02:00".
+func FormatLocalDateTime(t time.Time) string {
+	return t.Format(localDateTimeLayouts[0])
+}
+
+// wallClock returns the wall clock date and time of t within its location as
+// though it were in UTC.
+func wallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// IsLocalDateTime is a SchemaValidateFunc that tests if the provided value is
+// of type string and represents a wall clock date and time (see
+// ParseLocalDateTime).
+func IsLocalDateTime(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	if _, err := ParseLocalDateTime(v, time.UTC); err != nil {
+		return nil, []error{fmt.Errorf("expected %q to be a valid local date and time: %+v", k, err)}
+	}
+
+	return nil, nil
+}
+
+// IsPositiveDuration is a SchemaValidateFunc that tests if the provided value
+// is of type string and represents a positive duration such as "45m".
+func IsPositiveDuration(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	if d, err := time.ParseDuration(v); err != nil || d <= 0 {
+		return nil, []error{fmt.Errorf("expected %q to be a positive duration such as \"45m\", got %q", k, v)}
+	}
+
+	return nil, nil
+}
+
+// ParseRelativeTime parses a string representing a time relative to now,
+// either "now" or "now+" followed by a duration such as "now+15m". It returns
+// false if the string does not represent a relative time.
+func ParseRelativeTime(s string, now time.Time) (time.Time, bool, error) {
+	if s == "now" {
+		return now, true, nil
+	}
+
+	offset, ok := strings.CutPrefix(s, "now+")
+	if !ok {
+		return time.Time{}, false, nil
+	}
+
+	d, err := time.ParseDuration(offset)
+	if err != nil || d < 0 {
+		return time.Time{}, true, fmt.Errorf("expected a relative time such as \"now+15m\", got %q", s)
+	}
+
+	return now.Add(d), true, nil
+}
+
+// IsRFC3339OrRelativeTime is a SchemaValidateFunc that tests if the provided
+// value is of type string and represents either an RFC3339 time or a time
+// relative to now (see ParseRelativeTime).
+func IsRFC3339OrRelativeTime(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	if _, relative, err := ParseRelativeTime(v, time.Now()); relative {
+		if err != nil {
+			return nil, []error{fmt.Errorf("expected %q to be a valid relative time: %+v", k, err)}
+		}
+		return nil, nil
+	}
+
+	if _, err := time.Parse(time.RFC3339, v); err != nil {
+		return nil, []error{fmt.Errorf("expected %q to be a valid RFC3339 date or a relative time such as \"now\", got %q", k, v)}
+	}
+
+	return nil, nil
+}
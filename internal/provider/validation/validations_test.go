@@ -1,8 +1,10 @@
 package validation_test
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/StatusCakeDev/terraform-provider-statuscake/v2/internal/provider/validation"
 )
@@ -138,6 +140,536 @@ func TestInt32InSlice(t *testing.T) {
 	})
 }
 
+func TestParseDuration(t *testing.T) {
+	t.Run("returns the number of units when the given value is a whole number", func(t *testing.T) {
+		n, err := validation.ParseDuration("300", time.Second)
+		if err != nil {
+			t.Error("expected no error but an error was returned")
+		}
+
+		if n != 300 {
+			t.Errorf("expected 300 but got %d", n)
+		}
+	})
+
+	t.Run("returns the number of units when the given value is a duration", func(t *testing.T) {
+		n, err := validation.ParseDuration("1h", time.Minute)
+		if err != nil {
+			t.Error("expected no error but an error was returned")
+		}
+
+		if n != 60 {
+			t.Errorf("expected 60 but got %d", n)
+		}
+	})
+
+	t.Run("returns an error when the value is not a whole number of units", func(t *testing.T) {
+		expected := `expected a whole number of minutes, got "90s"`
+
+		_, err := validation.ParseDuration("90s", time.Minute)
+		if err == nil {
+			t.Error("expected an error but no error was returned")
+		}
+
+		if err.Error() != expected {
+			t.Error("unexpected error message")
+		}
+	})
+
+	t.Run("returns an error when the value is neither a number nor a duration", func(t *testing.T) {
+		expected := `expected a number of seconds or a duration, got "enterprise"`
+
+		_, err := validation.ParseDuration("enterprise", time.Second)
+		if err == nil {
+			t.Error("expected an error but no error was returned")
+		}
+
+		if err.Error() != expected {
+			t.Error("unexpected error message")
+		}
+	})
+}
+
+func TestDurationInSlice(t *testing.T) {
+	t.Run("returns no errors when the given duration is contained within the validation slice", func(t *testing.T) {
+		_, errs := validation.DurationInSlice([]int32{30, 60, 300}, time.Second)("5m", "duration")
+		if errs != nil {
+			t.Error("expected no errors but errors were returned")
+		}
+	})
+
+	t.Run("returns an error when the value is not of type string", func(t *testing.T) {
+		expected := []string{`expected type of "duration" to be string`}
+
+		_, errs := validation.DurationInSlice([]int32{30, 60, 300}, time.Second)(1701, "duration")
+		if errs == nil {
+			t.Error("expected errors but no errors were returned")
+		}
+
+		if !reflect.DeepEqual(collect(errs), expected) {
+			t.Error("unexpected error message")
+		}
+	})
+
+	t.Run("returns an error naming the nearest value when the duration is not contained within the validation slice", func(t *testing.T) {
+		expected := []string{`expected "duration" to be one of [30 60 300] seconds, got 240: the nearest allowed value is 300 (5m0s)`}
+
+		_, errs := validation.DurationInSlice([]int32{30, 60, 300}, time.Second)("4m", "duration")
+		if errs == nil {
+			t.Error("expected errors but no errors were returned")
+		}
+
+		if !reflect.DeepEqual(collect(errs), expected) {
+			t.Error("unexpected error message")
+		}
+	})
+}
+
+func TestDurationBetween(t *testing.T) {
+	t.Run("returns no errors when the given duration is within the range", func(t *testing.T) {
+		_, errs := validation.DurationBetween(5, 75, time.Second)("1m", "duration")
+		if errs != nil {
+			t.Error("expected no errors but errors were returned")
+		}
+	})
+
+	t.Run("returns an error when the value is not of type string", func(t *testing.T) {
+		expected := []string{`expected type of "duration" to be string`}
+
+		_, errs := validation.DurationBetween(5, 75, time.Second)(1701, "duration")
+		if errs == nil {
+			t.Error("expected errors but no errors were returned")
+		}
+
+		if !reflect.DeepEqual(collect(errs), expected) {
+			t.Error("unexpected error message")
+		}
+	})
+
+	t.Run("returns an error when the duration is outside of the range", func(t *testing.T) {
+		expected := []string{`expected "duration" to be in the range (5 - 75) seconds, got 120`}
+
+		_, errs := validation.DurationBetween(5, 75, time.Second)("2m", "duration")
+		if errs == nil {
+			t.Error("expected errors but no errors were returned")
+		}
+
+		if !reflect.DeepEqual(collect(errs), expected) {
+			t.Error("unexpected error message")
+		}
+	})
+}
+
+func TestParseStatusCodes(t *testing.T) {
+	t.Run("returns the status code when the given value is a single status code", func(t *testing.T) {
+		codes, err := validation.ParseStatusCodes("404")
+		if err != nil {
+			t.Error("expected no error but an error was returned")
+		}
+
+		if !reflect.DeepEqual(codes, []int{404}) {
+			t.Errorf("unexpected status codes: %+v", codes)
+		}
+	})
+
+	t.Run("returns every status code within the class when the given value is a status code class", func(t *testing.T) {
+		codes, err := validation.ParseStatusCodes("5xx")
+		if err != nil {
+			t.Error("expected no error but an error was returned")
+		}
+
+		if len(codes) != 100 || codes[0] != 500 || codes[99] != 599 {
+			t.Errorf("unexpected status codes: %+v", codes)
+		}
+	})
+
+	t.Run("returns every status code within the range when the given value is a status code range", func(t *testing.T) {
+		codes, err := validation.ParseStatusCodes("400-404")
+		if err != nil {
+			t.Error("expected no error but an error was returned")
+		}
+
+		if !reflect.DeepEqual(codes, []int{400, 401, 402, 403, 404}) {
+			t.Errorf("unexpected status codes: %+v", codes)
+		}
+	})
+
+	t.Run("returns an error when the range is reversed", func(t *testing.T) {
+		expected := `expected the start of the status code range to not exceed the end, got "404-400"`
+
+		_, err := validation.ParseStatusCodes("404-400")
+		if err == nil {
+			t.Error("expected an error but no error was returned")
+		}
+
+		if err.Error() != expected {
+			t.Error("unexpected error message")
+		}
+	})
+
+	t.Run("returns an error when the status code is out of range", func(t *testing.T) {
+		expected := `expected status codes to be in the range (100 - 599), got "99999"`
+
+		_, err := validation.ParseStatusCodes("99999")
+		if err == nil {
+			t.Error("expected an error but no error was returned")
+		}
+
+		if err.Error() != expected {
+			t.Error("unexpected error message")
+		}
+	})
+}
+
+func TestIsStatusCodes(t *testing.T) {
+	t.Run("returns no errors when the given value represents status codes", func(t *testing.T) {
+		_, errs := validation.IsStatusCodes("4xx", "status_code")
+		if errs != nil {
+			t.Error("expected no errors but errors were returned")
+		}
+	})
+
+	t.Run("returns an error when the value is not of type string", func(t *testing.T) {
+		expected := []string{`expected type of "status_code" to be string`}
+
+		_, errs := validation.IsStatusCodes(404, "status_code")
+		if errs == nil {
+			t.Error("expected errors but no errors were returned")
+		}
+
+		if !reflect.DeepEqual(collect(errs), expected) {
+			t.Error("unexpected error message")
+		}
+	})
+
+	t.Run("returns an error when the value is an empty string", func(t *testing.T) {
+		expected := []string{`expected "status_code" status codes to not be empty, got ""`}
+
+		_, errs := validation.IsStatusCodes("", "status_code")
+		if errs == nil {
+			t.Error("expected errors but no errors were returned")
+		}
+
+		if !reflect.DeepEqual(collect(errs), expected) {
+			t.Error("unexpected error message")
+		}
+	})
+
+	t.Run("returns an error when the value does not represent status codes", func(t *testing.T) {
+		expected := []string{`expected "status_code" to be valid status codes: expected a status code, class or range, got "enterprise"`}
+
+		_, errs := validation.IsStatusCodes("enterprise", "status_code")
+		if errs == nil {
+			t.Error("expected errors but no errors were returned")
+		}
+
+		if !reflect.DeepEqual(collect(errs), expected) {
+			t.Error("unexpected error message")
+		}
+	})
+}
+
+func TestIsTimezone(t *testing.T) {
+	t.Run("returns no errors when the given value is a valid time zone", func(t *testing.T) {
+		_, errs := validation.IsTimezone("Europe/London", "timezone")
+		if errs != nil {
+			t.Error("expected no errors but errors were returned")
+		}
+	})
+
+	t.Run("returns an error when the value is not of type string", func(t *testing.T) {
+		expected := []string{`expected type of "timezone" to be string`}
+
+		_, errs := validation.IsTimezone(0, "timezone")
+		if errs == nil {
+			t.Error("expected errors but no errors were returned")
+		}
+
+		if !reflect.DeepEqual(collect(errs), expected) {
+			t.Error("unexpected error message")
+		}
+	})
+
+	for _, tz := range []string{"", "Local", "Europe/Atlantis", "GMT+25"} {
+		t.Run(fmt.Sprintf("returns an error when the value is %q", tz), func(t *testing.T) {
+			expected := []string{fmt.Sprintf(`expected "timezone" to be a valid IANA time zone, got %q`, tz)}
+
+			_, errs := validation.IsTimezone(tz, "timezone")
+			if errs == nil {
+				t.Error("expected errors but no errors were returned")
+			}
+
+			if !reflect.DeepEqual(collect(errs), expected) {
+				t.Error("unexpected error message")
+			}
+		})
+	}
+}
+
+func TestParseLocalDateTime(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		in       string
+		expected time.Time
+	}{
+		{in: "2026-11-01T02:00", expected: time.Date(2026, 11, 1, 2, 0, 0, 0, loc)},
+		{in: "2026-07-01T02:00:30", expected: time.Date(2026, 7, 1, 2, 0, 30, 0, loc)},
+	} {
+		t.Run(fmt.Sprintf("parses %q", tc.in), func(t *testing.T) {
+			got, err := validation.ParseLocalDateTime(tc.in, loc)
+			if err != nil {
+				t.Fatalf("expected no error but got %s", err)
+			}
+
+			if !got.Equal(tc.expected) {
+				t.Errorf("expected %s but got %s", tc.expected, got)
+			}
+		})
+	}
+
+	for _, in := range []string{"", "2026-11-01", "2026-11-01T02:00:00Z", "02:00"} {
+		t.Run(fmt.Sprintf("returns an error when parsing %q", in), func(t *testing.T) {
+			if _, err := validation.ParseLocalDateTime(in, loc); err == nil {
+				t.Error("expected an error but no error was returned")
+			}
+		})
+	}
+}
+
+func TestParseExactLocalDateTime(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		in       string
+		expected time.Time
+	}{
+		{in: "2026-03-29T00:30", expected: time.Date(2026, 3, 29, 0, 30, 0, 0, time.UTC)},
+		{in: "2026-03-29T02:00", expected: time.Date(2026, 3, 29, 1, 0, 0, 0, time.UTC)},
+		{in: "2026-10-25T00:59", expected: time.Date(2026, 10, 24, 23, 59, 0, 0, time.UTC)},
+		{in: "2026-10-25T02:00", expected: time.Date(2026, 10, 25, 2, 0, 0, 0, time.UTC)},
+	} {
+		t.Run(fmt.Sprintf("parses %q", tc.in), func(t *testing.T) {
+			got, err := validation.ParseExactLocalDateTime(tc.in, loc)
+			if err != nil {
+				t.Fatalf("expected no error but got %s", err)
+			}
+
+			if !got.Equal(tc.expected) {
+				t.Errorf("expected %s but got %s", tc.expected, got)
+			}
+		})
+	}
+
+	for _, tc := range []struct {
+		in       string
+		expected string
+	}{
+		{in: "2026-03-29T01:30", expected: `expected a local date and time that occurs within Europe/London, got "2026-03-29T01:30" which is skipped when the clocks go forward`},
+		{in: "2026-10-25T01:30", expected: `expected a local date and time that occurs once within Europe/London, got "2026-10-25T01:30" which is repeated when the clocks go back`},
+		{in: "2026-10-25", expected: `expected a local date and time such as "2006-01-02T15:04", got "2026-10-25"`},
+	} {
+		t.Run(fmt.Sprintf("returns an error when parsing %q", tc.in), func(t *testing.T) {
+			_, err := validation.ParseExactLocalDateTime(tc.in, loc)
+			if err == nil {
+				t.Fatal("expected an error but no error was returned")
+			}
+
+			if err.Error() != tc.expected {
+				t.Errorf("unexpected error message: %s", err)
+			}
+		})
+	}
+}
+
+func TestFormatLocalDateTime(t *testing.T) {
+	t.Run("formats the wall clock date and time", func(t *testing.T) {
+		loc, err := time.LoadLocation("Europe/London")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got := validation.FormatLocalDateTime(time.Date(2026, 7, 1, 1, 30, 0, 0, time.UTC).In(loc))
+		if got != "2026-07-01T02:30" {
+			t.Errorf("expected %q but got %q", "2026-07-01T02:30", got)
+		}
+	})
+}
+
+func TestIsLocalDateTime(t *testing.T) {
+	t.Run("returns no errors when the given value is a local date and time", func(t *testing.T) {
+		_, errs := validation.IsLocalDateTime("2026-11-01T02:00", "start_local")
+		if errs != nil {
+			t.Error("expected no errors but errors were returned")
+		}
+	})
+
+	t.Run("returns an error when the value is not of type string", func(t *testing.T) {
+		expected := []string{`expected type of "start_local" to be string`}
+
+		_, errs := validation.IsLocalDateTime(0, "start_local")
+		if errs == nil {
+			t.Error("expected errors but no errors were returned")
+		}
+
+		if !reflect.DeepEqual(collect(errs), expected) {
+			t.Error("unexpected error message")
+		}
+	})
+
+	t.Run("returns an error when the value includes an offset", func(t *testing.T) {
+		expected := []string{`expected "start_local" to be a valid local date and time: expected a local date and time such as "2006-01-02T15:04", got "2026-11-01T02:00:00Z"`}
+
+		_, errs := validation.IsLocalDateTime("2026-11-01T02:00:00Z", "start_local")
+		if errs == nil {
+			t.Error("expected errors but no errors were returned")
+		}
+
+		if !reflect.DeepEqual(collect(errs), expected) {
+			t.Error("unexpected error message")
+		}
+	})
+}
+
+func TestIsPositiveDuration(t *testing.T) {
+	t.Run("returns no errors when the given value is a positive duration", func(t *testing.T) {
+		_, errs := validation.IsPositiveDuration("45m", "duration")
+		if errs != nil {
+			t.Error("expected no errors but errors were returned")
+		}
+	})
+
+	t.Run("returns an error when the value is not of type string", func(t *testing.T) {
+		expected := []string{`expected type of "duration" to be string`}
+
+		_, errs := validation.IsPositiveDuration(45, "duration")
+		if errs == nil {
+			t.Error("expected errors but no errors were returned")
+		}
+
+		if !reflect.DeepEqual(collect(errs), expected) {
+			t.Error("unexpected error message")
+		}
+	})
+
+	for _, in := range []string{"", "45", "0s", "-5m"} {
+		t.Run(fmt.Sprintf("returns an error when the value is %q", in), func(t *testing.T) {
+			expected := []string{fmt.Sprintf(`expected "duration" to be a positive duration such as "45m", got %q`, in)}
+
+			_, errs := validation.IsPositiveDuration(in, "duration")
+			if errs == nil {
+				t.Error("expected errors but no errors were returned")
+			}
+
+			if !reflect.DeepEqual(collect(errs), expected) {
+				t.Error("unexpected error message")
+			}
+		})
+	}
+}
+
+func TestParseRelativeTime(t *testing.T) {
+	now := time.Date(2026, 11, 1, 2, 0, 0, 0, time.UTC)
+
+	for _, tc := range []struct {
+		in       string
+		expected time.Time
+	}{
+		{in: "now", expected: now},
+		{in: "now+15m", expected: now.Add(15 * time.Minute)},
+	} {
+		t.Run(fmt.Sprintf("parses %q", tc.in), func(t *testing.T) {
+			got, relative, err := validation.ParseRelativeTime(tc.in, now)
+			if err != nil {
+				t.Fatalf("expected no error but got %s", err)
+			}
+
+			if !relative {
+				t.Error("expected a relative time")
+			}
+
+			if !got.Equal(tc.expected) {
+				t.Errorf("expected %s but got %s", tc.expected, got)
+			}
+		})
+	}
+
+	t.Run("returns false when the value is not a relative time", func(t *testing.T) {
+		_, relative, err := validation.ParseRelativeTime("2026-11-01T02:00:00Z", now)
+		if err != nil {
+			t.Fatalf("expected no error but got %s", err)
+		}
+
+		if relative {
+			t.Error("expected the time not to be relative")
+		}
+	})
+
+	for _, in := range []string{"now+", "now+soon", "now+-5m"} {
+		t.Run(fmt.Sprintf("returns an error when parsing %q", in), func(t *testing.T) {
+			if _, _, err := validation.ParseRelativeTime(in, now); err == nil {
+				t.Error("expected an error but no error was returned")
+			}
+		})
+	}
+}
+
+func TestIsRFC3339OrRelativeTime(t *testing.T) {
+	for _, in := range []string{"2026-11-01T02:00:00Z", "now", "now+1h"} {
+		t.Run(fmt.Sprintf("returns no errors when the given value is %q", in), func(t *testing.T) {
+			_, errs := validation.IsRFC3339OrRelativeTime(in, "start")
+			if errs != nil {
+				t.Error("expected no errors but errors were returned")
+			}
+		})
+	}
+
+	t.Run("returns an error when the value is not of type string", func(t *testing.T) {
+		expected := []string{`expected type of "start" to be string`}
+
+		_, errs := validation.IsRFC3339OrRelativeTime(0, "start")
+		if errs == nil {
+			t.Error("expected errors but no errors were returned")
+		}
+
+		if !reflect.DeepEqual(collect(errs), expected) {
+			t.Error("unexpected error message")
+		}
+	})
+
+	t.Run("returns an error when the value is not a time", func(t *testing.T) {
+		expected := []string{`expected "start" to be a valid RFC3339 date or a relative time such as "now", got "tomorrow"`}
+
+		_, errs := validation.IsRFC3339OrRelativeTime("tomorrow", "start")
+		if errs == nil {
+			t.Error("expected errors but no errors were returned")
+		}
+
+		if !reflect.DeepEqual(collect(errs), expected) {
+			t.Error("unexpected error message")
+		}
+	})
+
+	t.Run("returns an error when the value is an invalid relative time", func(t *testing.T) {
+		expected := []string{`expected "start" to be a valid relative time: expected a relative time such as "now+15m", got "now+soon"`}
+
+		_, errs := validation.IsRFC3339OrRelativeTime("now+soon", "start")
+		if errs == nil {
+			t.Error("expected errors but no errors were returned")
+		}
+
+		if !reflect.DeepEqual(collect(errs), expected) {
+			t.Error("unexpected error message")
+		}
+	})
+}
+
 func collect(errs []error) []string {
 	strs := make([]string, len(errs))
 	for i, err := range errs {
@@ -3,7 +3,10 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
+	"io"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 
@@ -26,9 +29,36 @@ func main() {
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] [export]\n\n", os.Args[0])
+		fmt.Fprintln(flag.CommandLine.Output(), "By default the provider is served to Terraform. The export command writes")
+		fmt.Fprintln(flag.CommandLine.Output(), "configuration and import blocks for every object within the account to")
+		fmt.Fprintln(flag.CommandLine.Output(), "standard output, using the STATUSCAKE_* environment variables to connect.")
+		fmt.Fprintln(flag.CommandLine.Output())
+		flag.PrintDefaults()
+	}
 	flag.Parse()
 
-	opts := &plugin.ServeOpts{ProviderFunc: provider.Provider}
+	switch flag.Arg(0) {
+	case "":
+	case "export":
+		// Provider logs are only written when requested, as they are when the
+		// provider is run by Terraform.
+		if os.Getenv("TF_LOG") == "" {
+			log.SetOutput(io.Discard)
+		}
+
+		if err := provider.Export(context.Background(), os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	opts := &plugin.ServeOpts{GRPCProviderFunc: provider.ProviderServer}
 
 	if debug {
 		if err := plugin.Debug(context.Background(), "registry.terraform.io/StatusCakeDev/statuscake", opts); err != nil {